@@ -4,10 +4,14 @@ import (
 	"time"
 )
 
+// Layouts of the dates, also used by the date tags of the validator package
 const (
-	apiDateLayout  = "2006-01-02T15:04:05Z"
-	onlyDateLayout = "2006-01-02"
-	apiDBLayout    = "2006-01-02 15:04:05"
+	// APIDateLayout is the layout of the complete date of the APIs, in UTC
+	APIDateLayout = "2006-01-02T15:04:05Z"
+	// OnlyDateLayout is the layout of a date without time
+	OnlyDateLayout = "2006-01-02"
+	// APIDBLayout is the layout of the complete date of the database
+	APIDBLayout = "2006-01-02 15:04:05"
 )
 
 // GetDateNowTime return a complete date in format time.Time (UTC).
@@ -21,7 +25,7 @@ func GetDateNowTime() time.Time {
 //
 //	Ex: "2020-01-31T15:04:05Z"
 func GetCompleteDateNowString() string {
-	return GetDateNowTime().Format(apiDateLayout)
+	return GetDateNowTime().Format(APIDateLayout)
 }
 
 // GetCompleteDateNowDBLayout return a complete date in format to database.
@@ -30,14 +34,14 @@ func GetCompleteDateNowString() string {
 //
 // Generally is used to save date on database
 func GetCompleteDateNowDBLayout() string {
-	return GetDateNowTime().Format(apiDBLayout)
+	return GetDateNowTime().Format(APIDBLayout)
 }
 
 // GetOnlyDateNowString return a date in format string.
 //
 //	Ex: "2020-01-31"
 func GetOnlyDateNowString() string {
-	return GetDateNowTime().Format(onlyDateLayout)
+	return GetDateNowTime().Format(OnlyDateLayout)
 }
 
 // GetFirstAndLastOfAMonth return a first and last day of a month as date format string.
@@ -52,8 +56,8 @@ func GetOnlyDateNowString() string {
 //	12 to December;
 func GetFirstAndLastOfAMonth(month time.Month) (firstDay, lastDay string) {
 	y, _, _ := GetDateNowTime().Date()
-	firstDay = time.Date(y, month, 1, 0, 0, 0, 0, time.UTC).Format(onlyDateLayout)
-	lastDay = time.Date(y, month+1, 0, 0, 0, 0, 0, time.UTC).Format(onlyDateLayout)
+	firstDay = time.Date(y, month, 1, 0, 0, 0, 0, time.UTC).Format(OnlyDateLayout)
+	lastDay = time.Date(y, month+1, 0, 0, 0, 0, 0, time.UTC).Format(OnlyDateLayout)
 
 	return firstDay, lastDay
 }
@@ -0,0 +1,199 @@
+package validator
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Bank codes (COMPE) of the banks with the agency and account check digits validated by the bank_agency
+// and bank_account tags. The agencies and accounts of other banks are only checked to have digits.
+const (
+	BankBB        = "001"
+	BankSantander = "033"
+	BankCaixa     = "104"
+	BankBradesco  = "237"
+	BankItau      = "341"
+)
+
+// bankAccountMaskReplacer removes the mask of an agency or account, e.g. 00210169-6
+var bankAccountMaskReplacer = strings.NewReplacer(".", "", "-", "", " ", "")
+
+// bankAgencyRules are the check digit rules of the agency of each bank, the banks not listed have no agency check digit
+var bankAgencyRules = map[string]func(agency string) bool{
+	BankBB: func(agency string) bool {
+		return len(agency) == 5 && agency[4] == bankMod11Digit(agency[:4], []int{5, 4, 3, 2}, 'X', '0')
+	},
+	BankBradesco: func(agency string) bool {
+		return len(agency) == 5 && agency[4] == bankMod11Digit(agency[:4], []int{5, 4, 3, 2}, 'P', '0')
+	},
+}
+
+// bankAccountRules are the check digit rules of the account of each bank.
+// The agency is given without its check digit, since some banks use it in the account check digit.
+var bankAccountRules = map[string]func(agency, account string) bool{
+	BankBB: func(agency, account string) bool {
+		account = leftPad(account, 9)
+		return len(account) == 9 && account[8] == bankMod11Digit(account[:8], []int{9, 8, 7, 6, 5, 4, 3, 2}, 'X', '0')
+	},
+	BankBradesco: func(agency, account string) bool {
+		account = leftPad(account, 8)
+		return len(account) == 8 && account[7] == bankMod11Digit(account[:7], []int{2, 7, 6, 5, 4, 3, 2}, 'P', '0')
+	},
+	// the account of Itaú has 5 digits, the check digit is calculated with the agency
+	BankItau: func(agency, account string) bool {
+		if len(agency) != 4 || len(account) != 6 {
+			return false
+		}
+		return account[5] == mod10Digit(agency+account[:5])
+	},
+	// the account of Santander has 8 digits, the check digit is calculated with the agency, 2 zeros and the account
+	BankSantander: func(agency, account string) bool {
+		account = leftPad(account, 9)
+		if len(agency) != 4 || len(account) != 9 {
+			return false
+		}
+
+		base := agency + "00" + account[:8]
+		weights := []int{9, 7, 3, 1, 0, 0, 9, 7, 1, 3, 1, 9, 7, 3}
+		sum := 0
+		for i := range weights {
+			sum += int(base[i]-'0') * weights[i] % 10
+		}
+		return account[8] == byte('0'+(10-sum%10)%10)
+	},
+	// the account of Caixa has the operation (3 digits) and the number (8 digits), the check digit is calculated with the agency
+	BankCaixa: func(agency, account string) bool {
+		account = leftPad(account, 12)
+		if len(agency) != 4 || len(account) != 12 {
+			return false
+		}
+
+		base := agency + account[:11]
+		digit := weightedSum(base, []int{8, 7, 6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}) * 10 % 11
+		if digit == 10 {
+			digit = 0
+		}
+		return int(account[11]-'0') == digit
+	},
+}
+
+// bankValidations are the tags of the bank agencies and accounts
+var bankValidations = map[string]validator.Func{
+	// the param of bank_agency is the bank code, e.g. bank_agency=001, or the name of the sibling field with it
+	"bank_agency": func(fl validator.FieldLevel) bool {
+		bank, ok := bankParam(fl, fl.Param())
+		return ok && ValidBankAgency(bank, fl.Field().String())
+	},
+	// the params of bank_account are the bank code, or the sibling field with it, and the sibling field with the agency,
+	// e.g. bank_account=Bank Agency
+	"bank_account": func(fl validator.FieldLevel) bool {
+		params := strings.Fields(fl.Param())
+		if len(params) != 2 {
+			return false
+		}
+
+		bank, ok := bankParam(fl, params[0])
+		if !ok || fl.Parent().Kind() != reflect.Struct {
+			return false
+		}
+
+		agency, kind, _, ok := fl.GetStructFieldOKAdvanced2(fl.Parent(), params[1])
+		if !ok || kind != reflect.String {
+			return false
+		}
+
+		return ValidBankAccount(bank, agency.String(), fl.Field().String())
+	},
+}
+
+// bankParam returns the bank code of the param, which is the code or the name of the sibling field with it
+func bankParam(fl validator.FieldLevel, param string) (string, bool) {
+	if len(param) == 3 && onlyDigits(param) {
+		return param, true
+	}
+
+	// the values validated without a struct, e.g. by Var or ValidateMap, have no sibling fields
+	if fl.Parent().Kind() != reflect.Struct {
+		return "", false
+	}
+
+	field, kind, _, ok := fl.GetStructFieldOKAdvanced2(fl.Parent(), param)
+	if !ok || kind != reflect.String || field.String() == "" {
+		return "", false
+	}
+	return field.String(), true
+}
+
+// ValidBankAgency checks the agency of the bank (COMPE code, e.g. 001), with its check digit when the bank uses one,
+// e.g. 1584-9 for Banco do Brasil. The agencies of the banks without check digit have 4 digits.
+func ValidBankAgency(bank, agency string) bool {
+	agency = strings.ToUpper(bankAccountMaskReplacer.Replace(agency))
+	if agency == "" || !onlyDigits(agency[:len(agency)-1]) {
+		return false
+	}
+
+	if rule, ok := bankAgencyRules[bank]; ok {
+		return rule(agency)
+	}
+
+	return len(agency) == 4 && onlyDigits(agency)
+}
+
+// ValidBankAccount checks the account, with its check digit, of the agency of the bank (COMPE code, e.g. 341),
+// e.g. 02366-1 for the agency 2545 of Itaú. The agency can have its check digit, which is not used.
+// For Caixa the account has the operation, e.g. 001.00000448-6.
+// The accounts of the banks without known rules are only checked to have digits and a check digit.
+func ValidBankAccount(bank, agency, account string) bool {
+	agency = strings.ToUpper(bankAccountMaskReplacer.Replace(agency))
+	account = strings.ToUpper(bankAccountMaskReplacer.Replace(account))
+	if len(account) < 2 || !onlyDigits(account[:len(account)-1]) {
+		return false
+	}
+
+	// the agency check digit is not used by the account rules
+	if _, hasDigit := bankAgencyRules[bank]; hasDigit && len(agency) == 5 {
+		agency = agency[:4]
+	}
+
+	rule, ok := bankAccountRules[bank]
+	if !ok {
+		return onlyDigits(account) && len(account) <= 13
+	}
+
+	return onlyDigits(agency) && rule(agency, account)
+}
+
+// bankMod11Digit returns the module 11 check digit of the value, with the digit used when the result is 10 and 11
+func bankMod11Digit(value string, weights []int, ten, eleven byte) byte {
+	digit := 11 - weightedSum(value, weights)%11
+	switch digit {
+	case 10:
+		return ten
+	case 11:
+		return eleven
+	}
+	return byte('0' + digit)
+}
+
+// mod10Digit returns the module 10 check digit, with the weights 2 and 1 from right to left,
+// adding the digits of each product, used by the boletos and the Itaú accounts
+func mod10Digit(value string) byte {
+	sum := 0
+	weight := 2
+	for i := len(value) - 1; i >= 0; i-- {
+		product := int(value[i]-'0') * weight
+		sum += product/10 + product%10
+		weight = 3 - weight
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+// leftPad pads the value with zeros on the left up to the length
+func leftPad(value string, length int) string {
+	if len(value) >= length {
+		return value
+	}
+	return strings.Repeat("0", length-len(value)) + value
+}
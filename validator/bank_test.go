@@ -0,0 +1,106 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidBankAgency(t *testing.T) {
+	tests := []struct {
+		bank   string
+		agency string
+		want   bool
+	}{
+		{bank: BankBB, agency: "1584-9", want: true},
+		{bank: BankBB, agency: "15849", want: true},
+		{bank: BankBB, agency: "1009-X", want: true},
+		{bank: BankBB, agency: "1009-x", want: true},
+		{bank: BankBB, agency: "1584-8", want: false},
+		{bank: BankBB, agency: "1584", want: false},
+		{bank: BankBradesco, agency: "2345-0", want: true},
+		{bank: BankBradesco, agency: "1009-P", want: true},
+		{bank: BankBradesco, agency: "1009-X", want: false},
+		{bank: BankItau, agency: "2545", want: true},
+		{bank: BankItau, agency: "2545-1", want: false},
+		{bank: BankCaixa, agency: "2004", want: true},
+		{bank: "260", agency: "0001", want: true},
+		{bank: "260", agency: "00A1", want: false},
+		{bank: BankBB, agency: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.bank+" "+tt.agency, func(t *testing.T) {
+			assert.Equal(t, tt.want, ValidBankAgency(tt.bank, tt.agency))
+		})
+	}
+}
+
+func TestValidBankAccount(t *testing.T) {
+	tests := []struct {
+		bank    string
+		agency  string
+		account string
+		want    bool
+	}{
+		{bank: BankBB, agency: "1584-9", account: "00210169-6", want: true},
+		{bank: BankBB, agency: "1584", account: "210169-6", want: true},
+		{bank: BankBB, agency: "1584-9", account: "00210169-5", want: false},
+		{bank: BankBradesco, agency: "2345-0", account: "0123456-0", want: true},
+		{bank: BankBradesco, agency: "2345-0", account: "0123456-1", want: false},
+		{bank: BankItau, agency: "2545", account: "02366-1", want: true},
+		{bank: BankItau, agency: "2546", account: "02366-1", want: false},
+		{bank: BankItau, agency: "2545", account: "2366-1", want: false},
+		{bank: BankSantander, agency: "0001", account: "13000123-7", want: true},
+		{bank: BankSantander, agency: "0002", account: "13000123-7", want: false},
+		{bank: BankCaixa, agency: "2004", account: "001.00000448-6", want: true},
+		{bank: BankCaixa, agency: "2004", account: "00100000448-6", want: true},
+		{bank: BankCaixa, agency: "2005", account: "001.00000448-6", want: false},
+		{bank: "260", agency: "0001", account: "12345678-9", want: true},
+		{bank: "260", agency: "0001", account: "1234A678-9", want: false},
+		{bank: BankBB, agency: "1584-9", account: "6", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.bank+" "+tt.agency+" "+tt.account, func(t *testing.T) {
+			assert.Equal(t, tt.want, ValidBankAccount(tt.bank, tt.agency, tt.account))
+		})
+	}
+}
+
+func Test_validatorImpl_ValidateStruct_Bank(t *testing.T) {
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	type transfer struct {
+		Bank     string `json:"bank"`
+		Agency   string `json:"agency" validate:"bank_agency=Bank"`
+		Account  string `json:"account" validate:"bank_account=Bank Agency"`
+		BBAgency string `json:"bb_agency" validate:"omitempty,bank_agency=001"`
+	}
+
+	assert.NoError(t, v.ValidateStruct(context.Background(), transfer{Bank: BankItau, Agency: "2545", Account: "02366-1", BBAgency: "1584-9"}))
+
+	err = v.ValidateStruct(ContextWithLocale(context.Background(), LocalePTBR), transfer{Bank: BankItau, Agency: "2545", Account: "02366-2"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "O campo 'account' deve ser uma conta bancária válida")
+
+	err = v.ValidateStruct(context.Background(), transfer{Agency: "2545", Account: "02366-1", BBAgency: "1584-8"})
+	var fields []string
+	for _, fieldErr := range FieldErrors(err) {
+		fields = append(fields, fieldErr.Field)
+	}
+	assert.Equal(t, []string{"agency", "account", "bb_agency"}, fields)
+	assert.Contains(t, err.Error(), "The field 'agency' should be a valid bank agency")
+
+	// the values validated without a struct have no sibling fields
+	assert.NoError(t, v.Var("1584-9", "bank_agency=001"))
+	assert.Error(t, v.Var("1584-9", "bank_agency=Bank"))
+	assert.Error(t, v.Var("02366-1", "bank_account=341 Agency"))
+
+	err = v.ValidateMap(context.Background(), map[string]any{"agency": "1584-9"}, map[string]any{"agency": "bank_agency=Bank"})
+	if assert.Len(t, FieldErrors(err), 1) {
+		assert.Equal(t, "bank_agency", FieldErrors(err)[0].Tag)
+	}
+}
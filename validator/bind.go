@@ -0,0 +1,439 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/diegoclair/go_utils/resterrors"
+)
+
+// DefaultMaxBodySize is the max size of the request body read by Bind, 1MB
+const DefaultMaxBodySize int64 = 1 << 20
+
+var (
+	// queryTags are the struct tags, in order of precedence, used to read the query values
+	queryTags = []string{"query", "form"}
+
+	// pathTags are the struct tags used to read the path values, e.g. `path:"id"` for the pattern /users/{id}
+	pathTags = []string{"path"}
+
+	// defaultValidator is the validator used by Bind when none is given
+	defaultValidator = sync.OnceValues(func() (Validator, error) {
+		return NewValidator()
+	})
+
+	// defaultMessages builds the binding messages when the validator is not created by NewValidator, e.g. a mock
+	defaultMessages = sync.OnceValue(NewMessages)
+)
+
+// BindOption configures Bind
+type BindOption func(*bindOptions)
+
+type bindOptions struct {
+	validator             Validator
+	maxBodySize           int64
+	disallowUnknownFields bool
+}
+
+// WithBindValidator sets the validator used by Bind, so the custom validations and messages registered on it are used.
+// By default Bind uses a validator created with NewValidator.
+func WithBindValidator(v Validator) BindOption {
+	return func(o *bindOptions) {
+		o.validator = v
+	}
+}
+
+// WithMaxBodySize sets the max size in bytes of the request body, DefaultMaxBodySize by default.
+// Larger bodies return a 413 Request Entity Too Large error.
+func WithMaxBodySize(size int64) BindOption {
+	return func(o *bindOptions) {
+		o.maxBodySize = size
+	}
+}
+
+// WithDisallowUnknownFields rejects the body when it has fields that are not in the struct
+func WithDisallowUnknownFields() BindOption {
+	return func(o *bindOptions) {
+		o.disallowUnknownFields = true
+	}
+}
+
+// Bind decodes the request into a new T and validates it. The values are read in this order, each one
+// overriding the previous:
+//   - the JSON body
+//   - the query values, into the fields with the query (or form) tag, e.g. `query:"page"`
+//   - the path values of the http.ServeMux patterns, into the fields with the path tag, e.g. `path:"id"`
+//
+// Then the normalize tags are applied (see Normalize) and the struct is validated with ValidateStruct,
+// using the request context. All the errors are of type resterrors.RestErr:
+// a malformed body returns a bad request with the byte offset of the error, values of the wrong type
+// and unknown fields return a bad request with a list of FieldError as causes, and the validation errors
+// are returned as they come from ValidateStruct.
+func Bind[T any](r *http.Request, opts ...BindOption) (*T, error) {
+	options := bindOptions{
+		maxBodySize: DefaultMaxBodySize,
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	v := options.validator
+	if v == nil {
+		var err error
+		v, err = defaultValidator()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := r.Context()
+	format := bindErrorFormat{messages: defaultMessages(), locale: LocaleFromContext(ctx)}
+	if impl, ok := v.(*validatorImpl); ok {
+		format = impl.bindErrorFormat(ctx)
+	}
+
+	dst := new(T)
+
+	err := decodeBody(r, dst, options, format)
+	if err != nil {
+		return nil, err
+	}
+
+	target := reflect.ValueOf(dst).Elem()
+	if target.Kind() == reflect.Struct {
+		query := r.URL.Query()
+		fieldErr := bindValues(target, queryTags, func(name string) []string {
+			return query[name]
+		})
+		if fieldErr == nil {
+			fieldErr = bindValues(target, pathTags, func(name string) []string {
+				if value := r.PathValue(name); value != "" {
+					return []string{value}
+				}
+				return nil
+			})
+		}
+		if fieldErr != nil {
+			return nil, format.newError(fieldErr.field, "type", fieldErr.typeName)
+		}
+	}
+
+	err = v.Normalize(ctx, dst)
+	if err != nil {
+		return nil, err
+	}
+
+	err = v.ValidateStruct(ctx, dst)
+	if err != nil {
+		return nil, err
+	}
+
+	return dst, nil
+}
+
+// decodeBody decodes the JSON body into dst. An empty body is accepted, so the fields can come only from
+// the query and path values.
+func decodeBody(r *http.Request, dst any, options bindOptions, format bindErrorFormat) error {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(nil, r.Body, options.maxBodySize))
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return resterrors.NewRestError(fmt.Sprintf("Request body too large, the limit is %d bytes", maxBytesErr.Limit),
+			http.StatusRequestEntityTooLarge, http.StatusText(http.StatusRequestEntityTooLarge))
+	}
+	if err != nil {
+		return resterrors.NewBadRequestError("Error trying to read the request body", err)
+	}
+
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+
+	return decodeJSON(body, dst, options.disallowUnknownFields, format)
+}
+
+// decodeJSON decodes the body into dst, converting the decoding errors into resterrors.RestErr
+func decodeJSON(body []byte, dst any, disallowUnknownFields bool, format bindErrorFormat) error {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	if disallowUnknownFields {
+		decoder.DisallowUnknownFields()
+	}
+
+	err := decoder.Decode(dst)
+
+	// the body must have a single JSON value
+	if err == nil {
+		_, err = decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err == nil {
+			return resterrors.NewBadRequestError(fmt.Sprintf("Malformed JSON at byte offset %d: the body must have a single JSON value", decoder.InputOffset()))
+		}
+	}
+
+	var (
+		syntaxErr    *json.SyntaxError
+		typeErr      *json.UnmarshalTypeError
+		unknownField string
+	)
+	switch {
+	case errors.As(err, &syntaxErr):
+		return resterrors.NewBadRequestError(fmt.Sprintf("Malformed JSON at byte offset %d: %s", syntaxErr.Offset, syntaxErr.Error()))
+
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		return resterrors.NewBadRequestError(fmt.Sprintf("Malformed JSON at byte offset %d: unexpected end of the body", len(body)))
+
+	case errors.As(err, &typeErr):
+		field := typeErr.Field
+		if field == "" {
+			field = typeErr.Value
+		}
+		return format.newError(field, "type", jsonTypeName(typeErr.Type))
+
+	case isUnknownFieldError(err, &unknownField):
+		return format.newError(unknownField, "unknown_field", "")
+	}
+
+	return resterrors.NewBadRequestError("Invalid request body: " + err.Error())
+}
+
+// isUnknownFieldError reports whether the error is the one returned by DisallowUnknownFields, which has no type,
+// e.g. json: unknown field "nickname"
+func isUnknownFieldError(err error, field *string) bool {
+	name, ok := strings.CutPrefix(err.Error(), "json: unknown field ")
+	if !ok {
+		return false
+	}
+
+	*field = strings.Trim(name, `"`)
+	return true
+}
+
+// bindErrorFormat builds the errors of the values that can not be bound, with the messages of the validator
+type bindErrorFormat struct {
+	messages      *Messages
+	locale        string
+	messageCauses bool
+}
+
+// bindErrorFormat returns the format of the bind errors with the messages, the locale and the causes of the validator
+func (v *validatorImpl) bindErrorFormat(ctx context.Context) bindErrorFormat {
+	return bindErrorFormat{messages: v.messages, locale: v.locale(ctx), messageCauses: v.messageCauses}
+}
+
+// newError returns a bad request with the FieldError as cause, in the same format of ValidateStruct,
+// or with its message with WithMessageCauses
+func (f bindErrorFormat) newError(field, tag, param string) error {
+	return resterrors.NewBadRequestError("Invalid input data", fieldErrorCauses([]FieldError{
+		{
+			Field:   field,
+			Tag:     tag,
+			Param:   param,
+			Message: f.messages.Message(f.locale, tag, field, param),
+		},
+	}, f.messageCauses))
+}
+
+// bindFieldError is the field that could not be set by bindValues and the type it expects
+type bindFieldError struct {
+	field    string
+	typeName string
+}
+
+// bindValues sets the fields of the struct named by one of the tags with the values returned by lookup.
+// The fields of anonymous embedded structs are promoted, even when the struct type is unexported, as in encoding/json.
+// The nil embedded pointers with fields named by the tags are allocated, as encoding/json does for the body,
+// so their fields are bound and validated, e.g. the required of an ID of an embedded *Base.
+func bindValues(value reflect.Value, tags []string, lookup func(name string) []string) *bindFieldError {
+	return bindEmbeddedValues(value, tags, lookup, map[reflect.Type]bool{})
+}
+
+func bindEmbeddedValues(value reflect.Value, tags []string, lookup func(name string) []string, visited map[reflect.Type]bool) *bindFieldError {
+	typ := value.Type()
+	if visited[typ] {
+		return nil
+	}
+	visited[typ] = true
+	defer delete(visited, typ)
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			if err := bindEmbeddedValues(value.Field(i), tags, lookup, visited); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// the pointers to unexported types can not be set, and are skipped as encoding/json does
+		if field.Anonymous && field.Type.Kind() == reflect.Ptr && field.Type.Elem().Kind() == reflect.Struct {
+			embedded := value.Field(i)
+			if embedded.IsNil() {
+				if !embedded.CanSet() || visited[field.Type.Elem()] || !hasBindTags(field.Type.Elem(), tags, map[reflect.Type]bool{}) {
+					continue
+				}
+				embedded.Set(reflect.New(field.Type.Elem()))
+			}
+			if err := bindEmbeddedValues(embedded.Elem(), tags, lookup, visited); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		name := tagName(field, tags)
+		if name == "" {
+			continue
+		}
+
+		values := lookup(name)
+		if len(values) == 0 {
+			continue
+		}
+
+		err := setValue(value.Field(i), values)
+		if err != nil {
+			return &bindFieldError{field: name, typeName: jsonTypeName(field.Type)}
+		}
+	}
+
+	return nil
+}
+
+// hasBindTags reports whether the struct, or one of its embedded structs, has a field named by one of the tags
+func hasBindTags(typ reflect.Type, tags []string, visited map[reflect.Type]bool) bool {
+	if visited[typ] {
+		return false
+	}
+	visited[typ] = true
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if embedded := indirectType(field.Type); field.Anonymous && embedded.Kind() == reflect.Struct {
+			if hasBindTags(embedded, tags, visited) {
+				return true
+			}
+			continue
+		}
+		if field.IsExported() && tagName(field, tags) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// tagName returns the name of the field in the first of the tags that is set
+func tagName(field reflect.StructField, tags []string) string {
+	for _, key := range tags {
+		name, _, _ := strings.Cut(field.Tag.Get(key), ",")
+		if name != "" && name != "-" {
+			return name
+		}
+	}
+	return ""
+}
+
+// setValue parses the values into the field. Slices receive all the values, the other kinds only the first one.
+// Types implementing encoding.TextUnmarshaler, like time.Time, are parsed by their own method.
+func setValue(field reflect.Value, values []string) error {
+	if field.Kind() == reflect.Ptr {
+		elem := reflect.New(field.Type().Elem())
+		err := setValue(elem.Elem(), values)
+		if err != nil {
+			return err
+		}
+		field.Set(elem)
+		return nil
+	}
+
+	if unmarshaler, ok := field.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText([]byte(values[0]))
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(values[0])
+
+	case reflect.Bool:
+		b, err := strconv.ParseBool(values[0])
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(values[0], 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(values[0], 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(n)
+
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(values[0], field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetFloat(n)
+
+	case reflect.Slice:
+		slice := reflect.MakeSlice(field.Type(), len(values), len(values))
+		for i, value := range values {
+			err := setValue(slice.Index(i), []string{value})
+			if err != nil {
+				return err
+			}
+		}
+		field.Set(slice)
+
+	default:
+		return fmt.Errorf("unsupported type %s", field.Type())
+	}
+
+	return nil
+}
+
+// jsonTypeName returns the name of the type as the client knows it, e.g. number for an int
+func jsonTypeName(typ reflect.Type) string {
+	typ = indirectType(typ)
+
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	case reflect.Struct, reflect.Map:
+		return "object"
+	}
+
+	return typ.String()
+}
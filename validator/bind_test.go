@@ -0,0 +1,230 @@
+package validator
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/stretchr/testify/assert"
+)
+
+type bindAddress struct {
+	ZipCode string `json:"zip_code" validate:"required,cep"`
+}
+
+type bindPagination struct {
+	Page  int `query:"page" validate:"omitempty,min=1"`
+	Limit int `form:"limit"`
+}
+
+type bindRequest struct {
+	bindPagination
+	ID      int64        `path:"id" validate:"required"`
+	Name    string       `json:"name" normalize:"trim,collapse_spaces" validate:"required_trim"`
+	Email   string       `json:"email" mod:"trim,lower" validate:"required,email"`
+	Age     *int         `json:"age" validate:"omitempty,min=18"`
+	Tags    []string     `query:"tag"`
+	Active  bool         `query:"active"`
+	Address *bindAddress `json:"address" validate:"omitempty"`
+}
+
+func newBindRequest(target, body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(body))
+	req.SetPathValue("id", "42")
+	return req
+}
+
+func TestBind(t *testing.T) {
+	t.Run("decodes the body, query and path values", func(t *testing.T) {
+		req := newBindRequest("/users/42?page=2&limit=10&tag=a&tag=b&active=true",
+			`{"name": "  John   Doe ", "email": " John@Example.com", "age": 30, "address": {"zip_code": "01310-100"}}`)
+
+		got, err := Bind[bindRequest](req)
+		assert.NoError(t, err)
+
+		age := 30
+		assert.Equal(t, &bindRequest{
+			bindPagination: bindPagination{Page: 2, Limit: 10},
+			ID:             42,
+			Name:           "John Doe",
+			Email:          "john@example.com",
+			Age:            &age,
+			Tags:           []string{"a", "b"},
+			Active:         true,
+			Address:        &bindAddress{ZipCode: "01310-100"},
+		}, got)
+	})
+
+	t.Run("empty body with values from the path", func(t *testing.T) {
+		type getRequest struct {
+			ID int64 `path:"id" validate:"required"`
+		}
+		req := httptest.NewRequest(http.MethodGet, "/users/42", nil)
+		req.SetPathValue("id", "42")
+
+		got, err := Bind[getRequest](req)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(42), got.ID)
+	})
+
+	t.Run("returns the validation errors of ValidateStruct", func(t *testing.T) {
+		req := newBindRequest("/users/42?page=0", `{"name": "   ", "email": "john", "address": {"zip_code": "1"}}`)
+
+		got, err := Bind[bindRequest](req)
+		assert.Nil(t, got)
+
+		restErr, ok := err.(resterrors.RestErr)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, restErr.StatusCode())
+
+		var fields []string
+		for _, fieldErr := range restErr.Causes().([]any)[0].([]FieldError) {
+			fields = append(fields, fieldErr.Field+":"+fieldErr.Tag)
+		}
+		assert.ElementsMatch(t, []string{"name:required_trim", "email:email", "address.zip_code:cep"}, fields)
+	})
+
+	tests := []struct {
+		name       string
+		target     string
+		body       string
+		opts       []BindOption
+		wantStatus int
+		wantMsg    string
+		wantCause  *FieldError
+	}{
+		{
+			name:       "malformed JSON",
+			target:     "/users/42",
+			body:       `{"name": "John",, "email": "john@example.com"}`,
+			wantStatus: http.StatusBadRequest,
+			wantMsg:    "Malformed JSON at byte offset 17: invalid character ',' looking for beginning of object key string",
+		},
+		{
+			name:       "truncated JSON",
+			target:     "/users/42",
+			body:       `{"name": "John"`,
+			wantStatus: http.StatusBadRequest,
+			wantMsg:    "Malformed JSON at byte offset 15: unexpected end of the body",
+		},
+		{
+			name:       "more than one JSON value",
+			target:     "/users/42",
+			body:       `{"name": "John"} {}`,
+			wantStatus: http.StatusBadRequest,
+			wantMsg:    "Malformed JSON at byte offset 18: the body must have a single JSON value",
+		},
+		{
+			name:       "wrong type in the body",
+			target:     "/users/42",
+			body:       `{"name": "John", "address": {"zip_code": 1310100}}`,
+			wantStatus: http.StatusBadRequest,
+			wantMsg:    "Invalid input data",
+			wantCause:  &FieldError{Field: "address.zip_code", Tag: "type", Param: "string", Message: "The field 'address.zip_code' should be of type string"},
+		},
+		{
+			name:       "wrong type in the query",
+			target:     "/users/42?page=first",
+			body:       `{}`,
+			wantStatus: http.StatusBadRequest,
+			wantMsg:    "Invalid input data",
+			wantCause:  &FieldError{Field: "page", Tag: "type", Param: "number", Message: "The field 'page' should be of type number"},
+		},
+		{
+			name:       "unknown field is ignored by default",
+			target:     "/users/42",
+			body:       `{"name": "John", "email": "john@example.com", "nickname": "Johnny"}`,
+			wantStatus: 0,
+		},
+		{
+			name:       "unknown field",
+			target:     "/users/42",
+			body:       `{"name": "John", "email": "john@example.com", "nickname": "Johnny"}`,
+			opts:       []BindOption{WithDisallowUnknownFields()},
+			wantStatus: http.StatusBadRequest,
+			wantMsg:    "Invalid input data",
+			wantCause:  &FieldError{Field: "nickname", Tag: "unknown_field", Message: "The field 'nickname' is not allowed"},
+		},
+		{
+			name:       "body too large",
+			target:     "/users/42",
+			body:       `{"name": "John", "email": "john@example.com"}`,
+			opts:       []BindOption{WithMaxBodySize(10)},
+			wantStatus: http.StatusRequestEntityTooLarge,
+			wantMsg:    "Request body too large, the limit is 10 bytes",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Bind[bindRequest](newBindRequest(tt.target, tt.body), tt.opts...)
+			if tt.wantStatus == 0 {
+				assert.NoError(t, err)
+				return
+			}
+
+			restErr, ok := err.(resterrors.RestErr)
+			assert.True(t, ok)
+			assert.Equal(t, tt.wantStatus, restErr.StatusCode())
+			assert.Equal(t, tt.wantMsg, restErr.Message())
+
+			if tt.wantCause != nil {
+				assert.Equal(t, []FieldError{*tt.wantCause}, restErr.Causes().([]any)[0].([]FieldError))
+			}
+		})
+	}
+
+	t.Run("uses the given validator", func(t *testing.T) {
+		v, err := NewValidator()
+		assert.NoError(t, err)
+		v.RegisterMessage(LocaleEN, "type", "'{field}' must be a {param}")
+
+		_, err = Bind[bindRequest](newBindRequest("/users/42?page=first", `{}`), WithBindValidator(v))
+		restErr := err.(resterrors.RestErr)
+		assert.Equal(t, "'page' must be a number", restErr.Causes().([]any)[0].([]FieldError)[0].Message)
+	})
+
+	t.Run("returns the messages as causes with WithMessageCauses", func(t *testing.T) {
+		v, err := NewValidator(WithMessageCauses())
+		assert.NoError(t, err)
+
+		_, err = Bind[bindRequest](newBindRequest("/users/42?page=first", `{}`), WithBindValidator(v))
+		restErr := err.(resterrors.RestErr)
+		assert.Equal(t, http.StatusBadRequest, restErr.StatusCode())
+		assert.Equal(t, []any{[]string{"The field 'page' should be of type number"}}, restErr.Causes())
+
+		_, err = Bind[bindRequest](newBindRequest("/users/42", `{"nickname": "Johnny"}`), WithBindValidator(v), WithDisallowUnknownFields())
+		restErr = err.(resterrors.RestErr)
+		assert.Equal(t, []any{[]string{"The field 'nickname' is not allowed"}}, restErr.Causes())
+	})
+
+	t.Run("binds the fields of an embedded pointer", func(t *testing.T) {
+		type Base struct {
+			ID int64 `query:"id" validate:"required"`
+		}
+		type getRequest struct {
+			*Base
+			Name string `query:"name"`
+		}
+
+		got, err := Bind[getRequest](httptest.NewRequest(http.MethodGet, "/users?id=5&name=John", nil))
+		assert.NoError(t, err)
+		assert.Equal(t, &getRequest{Base: &Base{ID: 5}, Name: "John"}, got)
+
+		_, err = Bind[getRequest](httptest.NewRequest(http.MethodGet, "/users?name=John", nil))
+		assert.Equal(t, []FieldError{
+			{Field: "id", Tag: "required", Value: int64(0), Message: "The field 'id' is required"},
+		}, FieldErrors(err))
+
+		// the embedded pointers without bind tags are left nil
+		type listRequest struct {
+			*bindAddress
+			Page int `query:"page"`
+		}
+		list, err := Bind[listRequest](httptest.NewRequest(http.MethodGet, "/users?page=2", nil))
+		assert.NoError(t, err)
+		assert.Nil(t, list.bindAddress)
+	})
+}
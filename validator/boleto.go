@@ -0,0 +1,248 @@
+package validator
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/diegoclair/go_utils/dateutils"
+	"github.com/go-playground/validator/v10"
+)
+
+// BoletoType is the type of a boleto, as defined by FEBRABAN
+type BoletoType string
+
+// Boleto types
+const (
+	// BoletoBank is the bank slip (boleto bancário), with a 47 digits digitable line
+	BoletoBank BoletoType = "bank"
+	// BoletoCollection is the utility bill, tax or fee (arrecadação), with a 48 digits digitable line
+	BoletoCollection BoletoType = "collection"
+)
+
+var (
+	// boletoMaskReplacer removes the mask of a digitable line, e.g. 00190.50095 40144.816069 06809.350314 3 37370000000100
+	boletoMaskReplacer = strings.NewReplacer(".", "", "-", "", " ", "")
+
+	// boletoFactorBase is the date of the due date factor 0, the factor 1000 is 2000-07-03
+	boletoFactorBase = time.Date(1997, time.October, 7, 0, 0, 0, 0, time.UTC)
+	// boletoFactorRollover is the date of the factor 1000 after the factor 9999 (2025-02-21) was reached
+	boletoFactorRollover = time.Date(2025, time.February, 22, 0, 0, 0, 0, time.UTC)
+)
+
+// Boleto is a parsed boleto, see ParseBoleto
+type Boleto struct {
+	Type BoletoType
+	// Barcode is the 44 digits of the barcode
+	Barcode string
+	// DigitableLine is the 47 (bank) or 48 (collection) digits of the digitable line, without mask
+	DigitableLine string
+	// BankCode is the COMPE code of the bank, only for bank boletos
+	BankCode string
+	// Amount is the amount in cents, it is 0 when the boleto has no amount,
+	// which includes the collection boletos with a reference value instead of the amount
+	Amount int64
+	// DueDateFactor is the number of days of the due date since the factor base, only for bank boletos.
+	// It is 0 when the boleto has no due date.
+	DueDateFactor int
+	// DueDate is the date of the factor, it is zero when the boleto has no due date
+	DueDate time.Time
+}
+
+// boletoValidations returns the tags of the boletos, parsed with the clock of the validator (see WithClock)
+func (v *validatorImpl) boletoValidations() map[string]validator.Func {
+	return map[string]validator.Func{
+		// the param of boleto is the type, e.g. boleto=bank, all the types are allowed when none is given
+		"boleto": func(fl validator.FieldLevel) bool {
+			boleto, ok := ParseBoletoAt(fl.Field().String(), v.now())
+			return ok && (fl.Param() == "" || string(boleto.Type) == fl.Param())
+		},
+	}
+}
+
+// ParseBoleto validates the check digits of the digitable line (47 or 48 digits) or of the barcode (44 digits)
+// of a boleto and returns its data. The digitable line is accepted with the usual mask.
+// It returns ok false if the code is not a valid boleto.
+//
+// The due date factor restarted at 1000 on 2025-02-22, so the due date is the one of the cycle closest to today,
+// from dateutils.GetDateNowTime. Use ParseBoletoAt to parse it with another date, e.g. in the tests.
+func ParseBoleto(code string) (boleto Boleto, ok bool) {
+	return ParseBoletoAt(code, dateutils.GetDateNowTime())
+}
+
+// ParseBoletoAt parses the boleto like ParseBoleto, with the due date of the cycle closest to now
+func ParseBoletoAt(code string, now time.Time) (boleto Boleto, ok bool) {
+	digits := boletoMaskReplacer.Replace(code)
+	if !onlyDigits(digits) {
+		return Boleto{}, false
+	}
+
+	var barcode string
+	switch len(digits) {
+	case 44:
+		barcode = digits
+	case 47:
+		barcode = bankLineBarcode(digits)
+	case 48:
+		barcode = collectionLineBarcode(digits)
+	}
+	if barcode == "" {
+		return Boleto{}, false
+	}
+
+	if barcode[0] == '8' {
+		return parseCollectionBarcode(barcode)
+	}
+	return parseBankBarcode(barcode, now)
+}
+
+// parseBankBarcode parses the barcode of a bank boleto:
+// bank (3), currency (1), check digit (1), due date factor (4), amount (10) and the free field of the bank (25)
+func parseBankBarcode(barcode string, now time.Time) (Boleto, bool) {
+	if barcode[4] != bankBarcodeDigit(barcode[:4]+barcode[5:]) {
+		return Boleto{}, false
+	}
+
+	factor, _ := strconv.Atoi(barcode[5:9])
+	amount, _ := strconv.ParseInt(barcode[9:19], 10, 64)
+
+	freeField := barcode[19:]
+	line := ""
+	for _, field := range []string{barcode[:4] + freeField[:5], freeField[5:15], freeField[15:]} {
+		line += field + string(mod10Digit(field))
+	}
+	line += barcode[4:19]
+
+	return Boleto{
+		Type:          BoletoBank,
+		Barcode:       barcode,
+		DigitableLine: line,
+		BankCode:      barcode[:3],
+		Amount:        amount,
+		DueDateFactor: factor,
+		DueDate:       boletoDueDate(factor, now),
+	}, true
+}
+
+// bankLineBarcode returns the barcode of the digitable line of a bank boleto, or empty if a field check digit is invalid.
+// The line has 3 fields with a module 10 check digit, the barcode check digit and the due date factor with the amount.
+func bankLineBarcode(line string) string {
+	fields := []string{line[0:9], line[10:20], line[21:31]}
+	digits := []byte{line[9], line[20], line[31]}
+	for i, field := range fields {
+		if mod10Digit(field) != digits[i] {
+			return ""
+		}
+	}
+
+	return line[0:4] + line[32:47] + line[4:9] + line[10:20] + line[21:31]
+}
+
+// bankBarcodeDigit returns the check digit of the barcode of a bank boleto, the value is the barcode without it
+func bankBarcodeDigit(value string) byte {
+	digit := 11 - mod11WeightedSum(value)%11
+	if digit == 0 || digit > 9 {
+		return '1'
+	}
+	return byte('0' + digit)
+}
+
+// boletoDueDate returns the date of the due date factor, of the cycle closest to now
+func boletoDueDate(factor int, now time.Time) time.Time {
+	if factor == 0 {
+		return time.Time{}
+	}
+
+	dueDate := boletoFactorBase.AddDate(0, 0, factor)
+	if factor < 1000 {
+		return dueDate
+	}
+
+	nextCycle := boletoFactorRollover.AddDate(0, 0, factor-1000)
+	if now.Sub(dueDate).Abs() > now.Sub(nextCycle).Abs() {
+		return nextCycle
+	}
+	return dueDate
+}
+
+// parseCollectionBarcode parses the barcode of a collection boleto: product (1, always 8), segment (1),
+// value identifier (1), check digit (1), amount or reference value (11) and the company data (29)
+func parseCollectionBarcode(barcode string) (Boleto, bool) {
+	checkDigit, ok := collectionCheckDigit(barcode[2])
+	if !ok || barcode[3] != checkDigit(barcode[:3]+barcode[4:]) {
+		return Boleto{}, false
+	}
+
+	line := ""
+	for i := 0; i < 44; i += 11 {
+		line += barcode[i:i+11] + string(checkDigit(barcode[i:i+11]))
+	}
+
+	boleto := Boleto{
+		Type:          BoletoCollection,
+		Barcode:       barcode,
+		DigitableLine: line,
+	}
+
+	// the identifiers 6 and 8 have the amount, 7 and 9 have a reference value, e.g. a quantity of an index
+	if barcode[2] == '6' || barcode[2] == '8' {
+		boleto.Amount, _ = strconv.ParseInt(barcode[4:15], 10, 64)
+	}
+
+	return boleto, true
+}
+
+// collectionLineBarcode returns the barcode of the digitable line of a collection boleto,
+// or empty if a block check digit is invalid. The line has 4 blocks of 11 digits, each with a check digit.
+func collectionLineBarcode(line string) string {
+	checkDigit, ok := collectionCheckDigit(line[2])
+	if line[0] != '8' || !ok {
+		return ""
+	}
+
+	barcode := ""
+	for i := 0; i < 48; i += 12 {
+		block := line[i : i+11]
+		if checkDigit(block) != line[i+11] {
+			return ""
+		}
+		barcode += block
+	}
+
+	return barcode
+}
+
+// collectionCheckDigit returns the check digit function of the value identifier of a collection boleto:
+// module 10 for 6 and 7 and module 11 for 8 and 9
+func collectionCheckDigit(identifier byte) (func(value string) byte, bool) {
+	switch identifier {
+	case '6', '7':
+		return mod10Digit, true
+	case '8', '9':
+		return collectionMod11Digit, true
+	}
+	return nil, false
+}
+
+// collectionMod11Digit returns the module 11 check digit of the collection boletos, 0 when the rest is 0 or 1
+func collectionMod11Digit(value string) byte {
+	rest := mod11WeightedSum(value) % 11
+	if rest < 2 {
+		return '0'
+	}
+	return byte('0' + 11 - rest)
+}
+
+// mod11WeightedSum multiplies the digits by the weights 2 to 9, from right to left, and returns the sum
+func mod11WeightedSum(value string) int {
+	sum := 0
+	weight := 2
+	for i := len(value) - 1; i >= 0; i-- {
+		sum += int(value[i]-'0') * weight
+		weight++
+		if weight > 9 {
+			weight = 2
+		}
+	}
+	return sum
+}
@@ -0,0 +1,109 @@
+package validator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBoletoAt(t *testing.T) {
+	now := time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+
+	bbBoleto := Boleto{
+		Type:          BoletoBank,
+		Barcode:       "00193373700000001000500940144816060680935031",
+		DigitableLine: "00190500954014481606906809350314337370000000100",
+		BankCode:      "001",
+		Amount:        100,
+		DueDateFactor: 3737,
+		DueDate:       time.Date(2032, time.August, 21, 0, 0, 0, 0, time.UTC),
+	}
+	itauBoleto := Boleto{
+		Type:          BoletoBank,
+		Barcode:       "34195100000000123451234567890123456789012345",
+		DigitableLine: "34191234546789012345767890123457510000000012345",
+		BankCode:      "341",
+		Amount:        12345,
+		DueDateFactor: 1000,
+		DueDate:       time.Date(2025, time.February, 22, 0, 0, 0, 0, time.UTC),
+	}
+	energyBill := Boleto{
+		Type:          BoletoCollection,
+		Barcode:       "82650000001234500000000000000000000000000001",
+		DigitableLine: "826500000011234500000000000000000000000000000018",
+		Amount:        12345,
+	}
+	taxBill := Boleto{
+		Type:          BoletoCollection,
+		Barcode:       "81800000000999012345678901234567890123456789",
+		DigitableLine: "818000000004999012345675890123456785901234567894",
+		Amount:        9990,
+	}
+
+	tests := []struct {
+		name string
+		code string
+		want Boleto
+		ok   bool
+	}{
+		{name: "bank digitable line with mask", code: "00190.50095 40144.816069 06809.350314 3 37370000000100", want: bbBoleto, ok: true},
+		{name: "bank digitable line", code: "00190500954014481606906809350314337370000000100", want: bbBoleto, ok: true},
+		{name: "bank barcode", code: "00193373700000001000500940144816060680935031", want: bbBoleto, ok: true},
+		{name: "due date factor after the rollover", code: "34191.23454 67890.123457 67890.123457 5 10000000012345", want: itauBoleto, ok: true},
+		{name: "collection digitable line with module 10", code: "82650000001-1 23450000000-0 00000000000-0 00000000001-8", want: energyBill, ok: true},
+		{name: "collection barcode with module 11", code: "81800000000999012345678901234567890123456789", want: taxBill, ok: true},
+		{name: "collection digitable line with module 11", code: "818000000004999012345675890123456785901234567894", want: taxBill, ok: true},
+		{name: "invalid field check digit", code: "00190.50096 40144.816069 06809.350314 3 37370000000100"},
+		{name: "invalid barcode check digit", code: "00190.50095 40144.816069 06809.350314 4 37370000000100"},
+		{name: "invalid bank barcode", code: "00194373700000001000500940144816060680935031"},
+		{name: "invalid block check digit", code: "826500000012234500000000000000000000000000000018"},
+		{name: "invalid collection barcode", code: "82660000001234500000000000000000000000000001"},
+		{name: "invalid value identifier", code: "82150000001234500000000000000000000000000001"},
+		{name: "invalid length", code: "0019050095401448160690680935031433737000000010"},
+		{name: "not digits", code: "0019A500954014481606906809350314337370000000100"},
+		{name: "empty", code: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseBoletoAt(tt.code, now)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_boletoDueDate(t *testing.T) {
+	now := time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Time{}, boletoDueDate(0, now))
+	assert.Equal(t, time.Date(2000, time.July, 3, 0, 0, 0, 0, time.UTC), boletoDueDate(1000, time.Date(2000, time.July, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(2025, time.February, 21, 0, 0, 0, 0, time.UTC), boletoDueDate(9999, now))
+	assert.Equal(t, time.Date(2025, time.February, 22, 0, 0, 0, 0, time.UTC), boletoDueDate(1000, now))
+	assert.Equal(t, time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC), boletoDueDate(1604, now))
+}
+
+func Test_validatorImpl_ValidateStruct_Boleto(t *testing.T) {
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	type payment struct {
+		Code     string `json:"code" validate:"boleto"`
+		BankCode string `json:"bank_code" validate:"omitempty,boleto=bank"`
+	}
+
+	assert.NoError(t, v.ValidateStruct(context.Background(), payment{
+		Code:     "82650000001-1 23450000000-0 00000000000-0 00000000001-8",
+		BankCode: "00190.50095 40144.816069 06809.350314 3 37370000000100",
+	}))
+
+	err = v.ValidateStruct(context.Background(), payment{
+		Code:     "00190.50095 40144.816069 06809.350314 4 37370000000100",
+		BankCode: "82650000001-1 23450000000-0 00000000000-0 00000000001-8",
+	})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "The field 'code' should be a valid boleto")
+	assert.Contains(t, err.Error(), "The field 'bank_code' should be a valid boleto")
+}
@@ -0,0 +1,249 @@
+package validator
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/diegoclair/go_utils/validator/brdocs"
+	"github.com/go-playground/validator/v10"
+)
+
+// brazilianDocumentValidations are the tags of the brazilian documents, besides cpf and cnpj
+var brazilianDocumentValidations = map[string]validator.Func{
+	"cep": func(fl validator.FieldLevel) bool {
+		return validCEP(fl.Field().String())
+	},
+	"cnh": func(fl validator.FieldLevel) bool {
+		return validCNH(fl.Field().String())
+	},
+	"renavam": func(fl validator.FieldLevel) bool {
+		return validRENAVAM(fl.Field().String())
+	},
+	"pis": func(fl validator.FieldLevel) bool {
+		return validPIS(fl.Field().String())
+	},
+	"titulo_eleitor": func(fl validator.FieldLevel) bool {
+		return validTituloEleitor(fl.Field().String())
+	},
+	"plate": func(fl validator.FieldLevel) bool {
+		return validPlate(fl.Field().String(), fl.Param())
+	},
+	"cns": func(fl validator.FieldLevel) bool {
+		return validCNS(fl.Field().String())
+	},
+	// the param of ie is the UF, e.g. ie=SP, or the name of the sibling field with the UF, e.g. ie=State.
+	// It panics without param, as go-playground does with the tags used wrongly.
+	"ie": func(fl validator.FieldLevel) bool {
+		uf := fl.Param()
+		if uf == "" {
+			panic("the ie tag needs the UF or the field with the UF as param, e.g. ie=SP or ie=State")
+		}
+		if !isUF(uf) {
+			// the values validated without a struct, e.g. by Var or ValidateMap, have no sibling fields
+			if fl.Parent().Kind() != reflect.Struct {
+				return false
+			}
+
+			field, kind, _, ok := fl.GetStructFieldOKAdvanced2(fl.Parent(), uf)
+			if !ok || kind != reflect.String || field.String() == "" {
+				return false
+			}
+			uf = field.String()
+		}
+
+		return validIE(fl.Field().String(), uf)
+	},
+}
+
+var (
+	cepRegex           = regexp.MustCompile(`^[0-9]{8}$`)
+	oldPlateRegex      = regexp.MustCompile(`^[A-Z]{3}-?[0-9]{4}$`)
+	mercosulPlateRegex = regexp.MustCompile(`^[A-Z]{3}[0-9][A-Z][0-9]{2}$`)
+)
+
+// onlyDigits reports whether the value has only ascii digits
+func onlyDigits(value string) bool {
+	for i := 0; i < len(value); i++ {
+		if value[i] < '0' || value[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// weightedSum multiplies each digit of the value by its weight and returns the sum
+func weightedSum(digits string, weights []int) int {
+	sum := 0
+	for i := 0; i < len(weights); i++ {
+		sum += int(digits[i]-'0') * weights[i]
+	}
+	return sum
+}
+
+// mod11Digit returns 11 minus the rest of the division of the sum by 11, or 0 when the result is greater than 9
+func mod11Digit(sum int) int {
+	digit := 11 - sum%11
+	if digit > 9 {
+		return 0
+	}
+	return digit
+}
+
+// descendingWeights returns the weights from n to 2, the most common in the brazilian check digits
+func descendingWeights(n int) []int {
+	weights := make([]int, 0, n-1)
+	for w := n; w >= 2; w-- {
+		weights = append(weights, w)
+	}
+	return weights
+}
+
+// validCEP checks the brazilian zip code, with or without mask, e.g. 01310-100
+func validCEP(value string) bool {
+	cep := brdocs.Unformat(value)
+	return cepRegex.MatchString(cep) && cep != "00000000"
+}
+
+// validCNH checks the check digits of the driver license (Carteira Nacional de Habilitação)
+func validCNH(value string) bool {
+	cnh := brdocs.Unformat(value)
+	if len(cnh) != 11 || !onlyDigits(cnh) || brdocs.AllSameChar(cnh) {
+		return false
+	}
+
+	discount := 0
+	firstDigit := weightedSum(cnh, []int{9, 8, 7, 6, 5, 4, 3, 2, 1}) % 11
+	if firstDigit >= 10 {
+		firstDigit = 0
+		discount = 2
+	}
+
+	secondDigit := weightedSum(cnh, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}) % 11
+	if secondDigit >= 10 {
+		secondDigit = 0
+	} else {
+		secondDigit -= discount
+	}
+
+	return secondDigit >= 0 && int(cnh[9]-'0') == firstDigit && int(cnh[10]-'0') == secondDigit
+}
+
+// validRENAVAM checks the check digit of the vehicle registration (Registro Nacional de Veículos Automotores).
+// The old 9 digits format is accepted and padded with zeros.
+func validRENAVAM(value string) bool {
+	renavam := brdocs.Unformat(value)
+	if len(renavam) == 9 {
+		renavam = "00" + renavam
+	}
+
+	if len(renavam) != 11 || !onlyDigits(renavam) || brdocs.AllSameChar(renavam) {
+		return false
+	}
+
+	digit := weightedSum(renavam, []int{3, 2, 9, 8, 7, 6, 5, 4, 3, 2}) * 10 % 11
+	if digit == 10 {
+		digit = 0
+	}
+
+	return int(renavam[10]-'0') == digit
+}
+
+// validPIS checks the check digit of the PIS, PASEP and NIT numbers, which share the same algorithm
+func validPIS(value string) bool {
+	pis := brdocs.Unformat(value)
+	if len(pis) != 11 || !onlyDigits(pis) || brdocs.AllSameChar(pis) {
+		return false
+	}
+
+	digit := mod11Digit(weightedSum(pis, []int{3, 2, 9, 8, 7, 6, 5, 4, 3, 2}))
+	return int(pis[10]-'0') == digit
+}
+
+// validTituloEleitor checks the check digits of the voter registration (Título de Eleitor).
+// The 9th and 10th digits are the code of the state, from 01 (SP) to 28 (ZZ, abroad).
+func validTituloEleitor(value string) bool {
+	titulo := brdocs.Unformat(value)
+	if len(titulo) != 12 || !onlyDigits(titulo) || brdocs.AllSameChar(titulo) {
+		return false
+	}
+
+	state := int(titulo[8]-'0')*10 + int(titulo[9]-'0')
+	if state < 1 || state > 28 {
+		return false
+	}
+
+	// SP and MG use 1 instead of 0 when the rest is 0
+	spOrMG := state == 1 || state == 2
+
+	titleDigit := func(sum int) int {
+		rest := sum % 11
+		switch {
+		case rest == 10:
+			return 0
+		case rest == 0 && spOrMG:
+			return 1
+		}
+		return rest
+	}
+
+	firstDigit := titleDigit(weightedSum(titulo, []int{2, 3, 4, 5, 6, 7, 8, 9}))
+	secondDigit := titleDigit(int(titulo[8]-'0')*7 + int(titulo[9]-'0')*8 + firstDigit*9)
+
+	return int(titulo[10]-'0') == firstDigit && int(titulo[11]-'0') == secondDigit
+}
+
+// Vehicle plate formats, used as param of the plate tag
+const (
+	PlateFormatOld      = "old"
+	PlateFormatMercosul = "mercosul"
+)
+
+// validPlate checks the vehicle plate in the old (ABC-1234) or in the Mercosul (ABC1D23) format.
+// The format can be restricted with PlateFormatOld or PlateFormatMercosul.
+func validPlate(value, format string) bool {
+	plate := strings.ToUpper(strings.TrimSpace(value))
+
+	switch format {
+	case PlateFormatOld:
+		return oldPlateRegex.MatchString(plate)
+	case PlateFormatMercosul:
+		return mercosulPlateRegex.MatchString(plate)
+	}
+
+	return oldPlateRegex.MatchString(plate) || mercosulPlateRegex.MatchString(plate)
+}
+
+// validCNS checks the health card number (Cartão Nacional de Saúde).
+// Definitive numbers start with 1 or 2 and are built from the PIS of the citizen,
+// provisional numbers start with 7, 8 or 9. In both cases the weighted sum is a multiple of 11.
+func validCNS(value string) bool {
+	cns := brdocs.Unformat(value)
+	if len(cns) != 15 || !onlyDigits(cns) {
+		return false
+	}
+
+	weights := descendingWeights(15)
+	weights = append(weights, 1)
+
+	switch cns[0] {
+	case '1', '2':
+		sum := weightedSum(cns, weights[:11])
+		digit := 11 - sum%11
+		suffix := "000"
+		if digit == 11 {
+			digit = 0
+		}
+		if digit == 10 {
+			sum += 2
+			digit = 11 - sum%11
+			suffix = "001"
+		}
+		return cns == cns[:11]+suffix+string(rune('0'+digit))
+
+	case '7', '8', '9':
+		return weightedSum(cns, weights)%11 == 0
+	}
+
+	return false
+}
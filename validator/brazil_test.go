@@ -0,0 +1,235 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validCEP(t *testing.T) {
+	tests := []struct {
+		cep  string
+		want bool
+	}{
+		{cep: "01310100", want: true},
+		{cep: "01310-100", want: true},
+		{cep: "01.310-100", want: true},
+		{cep: "0131010", want: false},
+		{cep: "013101000", want: false},
+		{cep: "0131A100", want: false},
+		{cep: "00000-000", want: false},
+		{cep: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.cep, func(t *testing.T) {
+			assert.Equal(t, tt.want, validCEP(tt.cep))
+		})
+	}
+}
+
+func Test_validCNH(t *testing.T) {
+	tests := []struct {
+		cnh  string
+		want bool
+	}{
+		{cnh: "02650306461", want: true},
+		{cnh: "54321098705", want: true},
+		{cnh: "00000001901", want: true},
+		{cnh: "026.503.064-61", want: true},
+		{cnh: "02650306462", want: false},
+		{cnh: "02650306451", want: false},
+		{cnh: "0265030646", want: false},
+		{cnh: "026503064611", want: false},
+		{cnh: "0265030646A", want: false},
+		{cnh: "11111111111", want: false},
+		{cnh: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.cnh, func(t *testing.T) {
+			assert.Equal(t, tt.want, validCNH(tt.cnh))
+		})
+	}
+}
+
+func Test_validRENAVAM(t *testing.T) {
+	tests := []struct {
+		renavam string
+		want    bool
+	}{
+		{renavam: "00639884962", want: true},
+		{renavam: "63900239830", want: true},
+		{renavam: "00123456789", want: true},
+		{renavam: "639884962", want: true},
+		{renavam: "00639884961", want: false},
+		{renavam: "63900239831", want: false},
+		{renavam: "6390023983", want: false},
+		{renavam: "639002398300", want: false},
+		{renavam: "6390023983X", want: false},
+		{renavam: "00000000000", want: false},
+		{renavam: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.renavam, func(t *testing.T) {
+			assert.Equal(t, tt.want, validRENAVAM(tt.renavam))
+		})
+	}
+}
+
+func Test_validPIS(t *testing.T) {
+	tests := []struct {
+		pis  string
+		want bool
+	}{
+		{pis: "12056048835", want: true},
+		{pis: "120.56048.83-5", want: true},
+		{pis: "17024685490", want: true},
+		{pis: "12056048836", want: false},
+		{pis: "17024685491", want: false},
+		{pis: "1205604883", want: false},
+		{pis: "120560488351", want: false},
+		{pis: "1205604883A", want: false},
+		{pis: "00000000000", want: false},
+		{pis: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pis, func(t *testing.T) {
+			assert.Equal(t, tt.want, validPIS(tt.pis))
+		})
+	}
+}
+
+func Test_validTituloEleitor(t *testing.T) {
+	tests := []struct {
+		name   string
+		titulo string
+		want   bool
+	}{
+		{name: "valid", titulo: "004356870906", want: true},
+		{name: "valid with spaces", titulo: "1023 8501 0671", want: true},
+		{name: "valid from SP with rest 0", titulo: "123456780191", want: true},
+		{name: "valid from MG with rest 0", titulo: "000000010299", want: true},
+		{name: "valid from abroad", titulo: "314159262860", want: true},
+		{name: "wrong first check digit", titulo: "004356870916", want: false},
+		{name: "wrong second check digit", titulo: "004356870907", want: false},
+		{name: "invalid state 00", titulo: "004356870006", want: false},
+		{name: "invalid state 29", titulo: "004356872906", want: false},
+		{name: "short", titulo: "00435687090", want: false},
+		{name: "letters", titulo: "00435687090A", want: false},
+		{name: "empty", titulo: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, validTituloEleitor(tt.titulo))
+		})
+	}
+}
+
+func Test_validPlate(t *testing.T) {
+	tests := []struct {
+		plate  string
+		format string
+		want   bool
+	}{
+		{plate: "ABC1234", want: true},
+		{plate: "ABC-1234", want: true},
+		{plate: "abc-1234", want: true},
+		{plate: "ABC1D23", want: true},
+		{plate: "ABC1234", format: PlateFormatOld, want: true},
+		{plate: "ABC1D23", format: PlateFormatOld, want: false},
+		{plate: "ABC1D23", format: PlateFormatMercosul, want: true},
+		{plate: "ABC1234", format: PlateFormatMercosul, want: false},
+		{plate: "ABC-1D23", want: false},
+		{plate: "AB1234", want: false},
+		{plate: "ABCD123", want: false},
+		{plate: "ABC12345", want: false},
+		{plate: "1BC1234", want: false},
+		{plate: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.plate+"/"+tt.format, func(t *testing.T) {
+			assert.Equal(t, tt.want, validPlate(tt.plate, tt.format))
+		})
+	}
+}
+
+func Test_validCNS(t *testing.T) {
+	tests := []struct {
+		name string
+		cns  string
+		want bool
+	}{
+		{name: "definitive", cns: "201234567890018", want: true},
+		{name: "definitive with suffix 001", cns: "100000000060018", want: true},
+		{name: "definitive with check digit 0 and spaces", cns: "123 4567 8901 0000", want: true},
+		{name: "definitive starting with 1", cns: "198765432100003", want: true},
+		{name: "provisional starting with 7", cns: "700000000000005", want: true},
+		{name: "provisional starting with 8", cns: "898001062109041", want: true},
+		{name: "definitive with wrong check digit", cns: "201234567890019", want: false},
+		{name: "definitive with wrong suffix", cns: "201234567890108", want: false},
+		{name: "provisional with wrong check digit", cns: "898001062109042", want: false},
+		{name: "invalid first digit", cns: "301234567890018", want: false},
+		{name: "short", cns: "20123456789001", want: false},
+		{name: "letters", cns: "20123456789001A", want: false},
+		{name: "empty", cns: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, validCNS(tt.cns))
+		})
+	}
+}
+
+func Test_validatorImpl_ValidateStruct_BrazilianDocuments(t *testing.T) {
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	type company struct {
+		State   string `json:"state"`
+		IE      string `json:"ie" validate:"ie=State"`
+		IESP    string `json:"ie_sp" validate:"omitempty,ie=SP"`
+		CEP     string `json:"cep" validate:"cep"`
+		CNH     string `json:"cnh" validate:"omitempty,cnh"`
+		RENAVAM string `json:"renavam" validate:"omitempty,renavam"`
+		PIS     string `json:"pis" validate:"omitempty,pis"`
+		Titulo  string `json:"titulo" validate:"omitempty,titulo_eleitor"`
+		Plate   string `json:"plate" validate:"omitempty,plate=mercosul"`
+		CNS     string `json:"cns" validate:"omitempty,cns"`
+	}
+
+	valid := company{
+		State: "SP", IE: "110.042.490.114", IESP: "110042490114", CEP: "01310-100", CNH: "02650306461",
+		RENAVAM: "00639884962", PIS: "12056048835", Titulo: "004356870906", Plate: "ABC1D23", CNS: "201234567890018",
+	}
+	assert.NoError(t, v.ValidateStruct(context.Background(), valid))
+
+	invalid := valid
+	invalid.State = "RJ"
+	invalid.Plate = "ABC1234"
+	invalid.CNS = "201234567890019"
+
+	err = v.ValidateStruct(ContextWithLocale(context.Background(), LocalePTBR), invalid)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "O campo 'ie' deve ser uma inscrição estadual válida")
+	assert.Contains(t, err.Error(), "O campo 'plate' deve ser uma placa de veículo válida")
+	assert.Contains(t, err.Error(), "O campo 'cns' deve ser um cartão nacional de saúde válido")
+
+	invalid = valid
+	invalid.State = ""
+	err = v.ValidateStruct(context.Background(), invalid)
+	assert.Contains(t, err.Error(), "The field 'ie' should be a valid state inscription")
+
+	// the UF is required, so the tag without param is a mistake of the struct
+	assert.Panics(t, func() { _ = v.Var("110042490114", "ie") })
+
+	// the UF is case insensitive, and a sibling field needs a struct
+	assert.NoError(t, v.Var("110.042.490.114", "ie=sp"))
+	assert.Error(t, v.Var("110.042.490.114", "ie=State"))
+}
@@ -0,0 +1,71 @@
+// Package brdocs provides validation, formatting, masking and generation of the brazilian
+// CPF and CNPJ documents, including the alphanumeric CNPJ issued by the Receita Federal since July 2026.
+//
+// The cpf and cnpj tags of the validator package use the check digits implementation of this package.
+package brdocs
+
+import (
+	"math/rand/v2"
+	"strings"
+)
+
+// maskReplacer removes the mask characters of a formatted document
+var maskReplacer = strings.NewReplacer(".", "", "-", "", "/", "", " ", "")
+
+// Unformat removes the mask characters of a document, e.g. a CPF or a CNPJ (dots, dashes, slashes and spaces),
+// and writes the letters, e.g. of an alphanumeric CNPJ, in uppercase.
+func Unformat(document string) string {
+	return strings.ToUpper(maskReplacer.Replace(document))
+}
+
+// checkDigit calculates the module 11 check digit of the base with the weights.
+// The value of each character is its ASCII code minus 48, so digits keep their value and A is 17.
+func checkDigit(base string, weights []int) byte {
+	sum := 0
+	for i := 0; i < len(base); i++ {
+		sum += int(base[i]-'0') * weights[i]
+	}
+
+	rest := sum % 11
+	if rest < 2 {
+		return '0'
+	}
+	return byte('0' + 11 - rest)
+}
+
+// AllSameChar reports whether all the characters are equal, e.g. 00000000000,
+// which pass the check digits calculation but are not valid documents.
+func AllSameChar(value string) bool {
+	return value != "" && strings.Count(value, value[:1]) == len(value)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isUpperLetter(c byte) bool {
+	return c >= 'A' && c <= 'Z'
+}
+
+// randomBase returns n random characters from the charset, using the global generator when rng is nil
+func randomBase(rng *rand.Rand, n int, charset string) string {
+	intN := rand.IntN
+	if rng != nil {
+		intN = rng.IntN
+	}
+
+	base := make([]byte, n)
+	for i := range base {
+		base[i] = charset[intN(len(charset))]
+	}
+	return string(base)
+}
+
+// mask replaces the characters of the formatted document in the given positions by *
+func mask(formatted string, positions []int) string {
+	masked := []byte(formatted)
+	for _, p := range positions {
+		masked[p] = '*'
+	}
+	return string(masked)
+}
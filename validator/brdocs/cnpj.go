@@ -0,0 +1,86 @@
+package brdocs
+
+import "math/rand/v2"
+
+const cnpjLength = 14
+
+var (
+	cnpjFirstDigitWeights  = []int{5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+	cnpjSecondDigitWeights = []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+
+	// cnpjMaskedPositions are the positions of the first 2 characters and of the check digits in 00.000.000/0000-00
+	cnpjMaskedPositions = []int{0, 1, 16, 17}
+)
+
+// IsValidCNPJ checks the CNPJ check digits, accepting the value with or without mask.
+// Since July 2026 the Receita Federal issues alphanumeric CNPJs, where the first 12 positions
+// can be letters, so both the numeric and the alphanumeric formats are valid.
+func IsValidCNPJ(cnpj string) bool {
+	return isValidCNPJ(cnpj, false)
+}
+
+// IsValidNumericCNPJ checks the CNPJ check digits accepting only the legacy numeric format.
+func IsValidNumericCNPJ(cnpj string) bool {
+	return isValidCNPJ(cnpj, true)
+}
+
+func isValidCNPJ(cnpj string, numericOnly bool) bool {
+	cnpj = Unformat(cnpj)
+	if len(cnpj) != cnpjLength || AllSameChar(cnpj) {
+		return false
+	}
+
+	for i := 0; i < cnpjLength; i++ {
+		// the check digits are always numeric
+		if !isDigit(cnpj[i]) && (numericOnly || i >= cnpjLength-2 || !isUpperLetter(cnpj[i])) {
+			return false
+		}
+	}
+
+	return cnpj[12] == checkDigit(cnpj[:12], cnpjFirstDigitWeights) &&
+		cnpj[13] == checkDigit(cnpj[:13], cnpjSecondDigitWeights)
+}
+
+// FormatCNPJ returns the CNPJ in the format 00.000.000/0000-00, e.g. 12.ABC.345/01DE-35.
+// The value is returned unchanged when it does not have 14 characters after removing the mask.
+func FormatCNPJ(cnpj string) string {
+	unformatted := Unformat(cnpj)
+	if len(unformatted) != cnpjLength {
+		return cnpj
+	}
+
+	return unformatted[:2] + "." + unformatted[2:5] + "." + unformatted[5:8] + "/" + unformatted[8:12] + "-" + unformatted[12:]
+}
+
+// UnformatCNPJ returns the CNPJ without mask and in uppercase, e.g. 12ABC34501DE35
+func UnformatCNPJ(cnpj string) string {
+	return Unformat(cnpj)
+}
+
+// MaskCNPJ returns the formatted CNPJ hiding the first 2 characters and the check digits, e.g. **.ABC.345/01DE-**.
+// The value is fully masked when it does not have 14 characters after removing the mask.
+func MaskCNPJ(cnpj string) string {
+	if len(Unformat(cnpj)) != cnpjLength {
+		return "**.***.***/****-**"
+	}
+
+	return mask(FormatCNPJ(cnpj), cnpjMaskedPositions)
+}
+
+// GenerateCNPJ returns a random valid numeric CNPJ without mask, for the headquarters (0001).
+// Pass a seeded generator to get the same documents on each run, or nil to use the global generator.
+func GenerateCNPJ(rng *rand.Rand) string {
+	return generateCNPJ(rng, "0123456789")
+}
+
+// GenerateAlphanumericCNPJ returns a random valid alphanumeric CNPJ without mask, for the headquarters (0001).
+// Pass a seeded generator to get the same documents on each run, or nil to use the global generator.
+func GenerateAlphanumericCNPJ(rng *rand.Rand) string {
+	return generateCNPJ(rng, "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ")
+}
+
+func generateCNPJ(rng *rand.Rand, charset string) string {
+	base := randomBase(rng, 8, charset) + "0001"
+	base += string(checkDigit(base, cnpjFirstDigitWeights))
+	return base + string(checkDigit(base, cnpjSecondDigitWeights))
+}
@@ -0,0 +1,118 @@
+package brdocs
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidCNPJ(t *testing.T) {
+	tests := []struct {
+		name        string
+		cnpj        string
+		numericOnly bool
+		want        bool
+	}{
+		{name: "official alphanumeric example with mask", cnpj: "12.ABC.345/01DE-35", want: true},
+		{name: "official alphanumeric example without mask", cnpj: "12ABC34501DE35", want: true},
+		{name: "alphanumeric example", cnpj: "1345C3A5000106", want: true},
+		{name: "alphanumeric example starting with a letter", cnpj: "R55231B3000757", want: true},
+		{name: "alphanumeric in lowercase", cnpj: "12abc34501de35", want: true},
+		{name: "numeric with mask", cnpj: "22.796.729/0001-59", want: true},
+		{name: "numeric without mask", cnpj: "73687174000148", want: true},
+		{name: "numeric", cnpj: "62009392000107", want: true},
+		{name: "numeric in strict mode", cnpj: "22.796.729/0001-59", numericOnly: true, want: true},
+		{name: "alphanumeric in strict mode", cnpj: "12.ABC.345/01DE-35", numericOnly: true, want: false},
+		{name: "alphanumeric with wrong first check digit", cnpj: "12ABC34501DE45", want: false},
+		{name: "alphanumeric with wrong second check digit", cnpj: "12ABC34501DE36", want: false},
+		{name: "numeric with wrong check digits", cnpj: "62009392000103", want: false},
+		{name: "letters in the check digits", cnpj: "12ABC34501DE3A", want: false},
+		{name: "invalid characters", cnpj: "12ABC34501D#35", want: false},
+		{name: "short", cnpj: "1234567891011", want: false},
+		{name: "long", cnpj: "227967290001590", want: false},
+		{name: "repeated digits", cnpj: "00000000000000", want: false},
+		{name: "empty", cnpj: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.numericOnly {
+				assert.Equal(t, tt.want, IsValidNumericCNPJ(tt.cnpj))
+				return
+			}
+			assert.Equal(t, tt.want, IsValidCNPJ(tt.cnpj))
+		})
+	}
+}
+
+func TestFormatCNPJ(t *testing.T) {
+	tests := []struct {
+		name string
+		cnpj string
+		want string
+	}{
+		{name: "numeric", cnpj: "22796729000159", want: "22.796.729/0001-59"},
+		{name: "alphanumeric", cnpj: "12ABC34501DE35", want: "12.ABC.345/01DE-35"},
+		{name: "alphanumeric in lowercase", cnpj: "12abc34501de35", want: "12.ABC.345/01DE-35"},
+		{name: "already formatted", cnpj: "22.796.729/0001-59", want: "22.796.729/0001-59"},
+		{name: "invalid length is returned unchanged", cnpj: "2279672900", want: "2279672900"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, FormatCNPJ(tt.cnpj))
+		})
+	}
+}
+
+func TestUnformatCNPJ(t *testing.T) {
+	assert.Equal(t, "22796729000159", UnformatCNPJ("22.796.729/0001-59"))
+	assert.Equal(t, "12ABC34501DE35", UnformatCNPJ("12.abc.345/01de-35"))
+}
+
+func TestMaskCNPJ(t *testing.T) {
+	tests := []struct {
+		name string
+		cnpj string
+		want string
+	}{
+		{name: "numeric", cnpj: "22796729000159", want: "**.796.729/0001-**"},
+		{name: "alphanumeric with mask", cnpj: "12.ABC.345/01DE-35", want: "**.ABC.345/01DE-**"},
+		{name: "invalid length is fully masked", cnpj: "2279672900", want: "**.***.***/****-**"},
+		{name: "invalid length with the formatted length is fully masked", cnpj: "123456789012345678", want: "**.***.***/****-**"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MaskCNPJ(tt.cnpj))
+		})
+	}
+}
+
+func TestGenerateCNPJ(t *testing.T) {
+	t.Run("generates valid numeric documents", func(t *testing.T) {
+		for i := 0; i < 1000; i++ {
+			cnpj := GenerateCNPJ(nil)
+			assert.Len(t, cnpj, cnpjLength)
+			assert.True(t, IsValidNumericCNPJ(cnpj), cnpj)
+		}
+	})
+
+	t.Run("generates valid alphanumeric documents", func(t *testing.T) {
+		for i := 0; i < 1000; i++ {
+			cnpj := GenerateAlphanumericCNPJ(nil)
+			assert.Len(t, cnpj, cnpjLength)
+			assert.True(t, IsValidCNPJ(cnpj), cnpj)
+		}
+	})
+
+	t.Run("same seed generates the same documents", func(t *testing.T) {
+		first := rand.New(rand.NewPCG(3, 4))
+		second := rand.New(rand.NewPCG(3, 4))
+
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, GenerateAlphanumericCNPJ(first), GenerateAlphanumericCNPJ(second))
+		}
+	})
+}
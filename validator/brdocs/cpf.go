@@ -0,0 +1,70 @@
+package brdocs
+
+import "math/rand/v2"
+
+const cpfLength = 11
+
+var (
+	cpfFirstDigitWeights  = []int{10, 9, 8, 7, 6, 5, 4, 3, 2}
+	cpfSecondDigitWeights = []int{11, 10, 9, 8, 7, 6, 5, 4, 3, 2}
+
+	// cpfMaskedPositions are the positions of the first 3 digits and of the check digits in 000.000.000-00
+	cpfMaskedPositions = []int{0, 1, 2, 12, 13}
+)
+
+// IsValidCPF checks the CPF check digits, accepting the value with or without mask.
+func IsValidCPF(cpf string) bool {
+	cpf = Unformat(cpf)
+	if len(cpf) != cpfLength || AllSameChar(cpf) {
+		return false
+	}
+
+	for i := 0; i < cpfLength; i++ {
+		if !isDigit(cpf[i]) {
+			return false
+		}
+	}
+
+	return cpf[9] == checkDigit(cpf[:9], cpfFirstDigitWeights) &&
+		cpf[10] == checkDigit(cpf[:10], cpfSecondDigitWeights)
+}
+
+// FormatCPF returns the CPF in the format 000.000.000-00.
+// The value is returned unchanged when it does not have 11 characters after removing the mask.
+func FormatCPF(cpf string) string {
+	unformatted := Unformat(cpf)
+	if len(unformatted) != cpfLength {
+		return cpf
+	}
+
+	return unformatted[:3] + "." + unformatted[3:6] + "." + unformatted[6:9] + "-" + unformatted[9:]
+}
+
+// UnformatCPF returns the CPF without mask, e.g. 12345678909
+func UnformatCPF(cpf string) string {
+	return Unformat(cpf)
+}
+
+// MaskCPF returns the formatted CPF hiding the first 3 digits and the check digits, e.g. ***.456.789-**,
+// the format recommended to display documents in logs and receipts.
+// The value is fully masked when it does not have 11 characters after removing the mask.
+func MaskCPF(cpf string) string {
+	if len(Unformat(cpf)) != cpfLength {
+		return "***.***.***-**"
+	}
+
+	return mask(FormatCPF(cpf), cpfMaskedPositions)
+}
+
+// GenerateCPF returns a random valid CPF without mask.
+// Pass a seeded generator, e.g. rand.New(rand.NewPCG(1, 2)), to get the same documents on each run,
+// or nil to use the global generator.
+func GenerateCPF(rng *rand.Rand) string {
+	base := randomBase(rng, 9, "0123456789")
+	for AllSameChar(base) {
+		base = randomBase(rng, 9, "0123456789")
+	}
+
+	base += string(checkDigit(base, cpfFirstDigitWeights))
+	return base + string(checkDigit(base, cpfSecondDigitWeights))
+}
@@ -0,0 +1,99 @@
+package brdocs
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidCPF(t *testing.T) {
+	tests := []struct {
+		name string
+		cpf  string
+		want bool
+	}{
+		{name: "with mask", cpf: "529.982.247-25", want: true},
+		{name: "without mask", cpf: "52998224725", want: true},
+		{name: "with spaces", cpf: "529 982 247 25", want: true},
+		{name: "check digit zero", cpf: "12345678909", want: true},
+		{name: "wrong first check digit", cpf: "52998224735", want: false},
+		{name: "wrong second check digit", cpf: "52998224726", want: false},
+		{name: "letters", cpf: "5299822472A", want: false},
+		{name: "short", cpf: "5299822472", want: false},
+		{name: "long", cpf: "529982247250", want: false},
+		{name: "repeated digits", cpf: "000.000.000-00", want: false},
+		{name: "empty", cpf: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsValidCPF(tt.cpf))
+		})
+	}
+}
+
+func TestFormatCPF(t *testing.T) {
+	tests := []struct {
+		name string
+		cpf  string
+		want string
+	}{
+		{name: "without mask", cpf: "52998224725", want: "529.982.247-25"},
+		{name: "already formatted", cpf: "529.982.247-25", want: "529.982.247-25"},
+		{name: "partial mask", cpf: "529982247-25", want: "529.982.247-25"},
+		{name: "invalid length is returned unchanged", cpf: "5299822", want: "5299822"},
+		{name: "empty", cpf: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, FormatCPF(tt.cpf))
+		})
+	}
+}
+
+func TestUnformatCPF(t *testing.T) {
+	assert.Equal(t, "52998224725", UnformatCPF("529.982.247-25"))
+	assert.Equal(t, "52998224725", UnformatCPF(" 529 982 247 25 "))
+	assert.Equal(t, "52998224725", UnformatCPF("52998224725"))
+}
+
+func TestMaskCPF(t *testing.T) {
+	tests := []struct {
+		name string
+		cpf  string
+		want string
+	}{
+		{name: "without mask", cpf: "52998224725", want: "***.982.247-**"},
+		{name: "with mask", cpf: "529.982.247-25", want: "***.982.247-**"},
+		{name: "invalid length is fully masked", cpf: "5299822", want: "***.***.***-**"},
+		{name: "invalid length with the formatted length is fully masked", cpf: "12345678000195", want: "***.***.***-**"},
+		{name: "empty", cpf: "", want: "***.***.***-**"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MaskCPF(tt.cpf))
+		})
+	}
+}
+
+func TestGenerateCPF(t *testing.T) {
+	t.Run("generates valid documents", func(t *testing.T) {
+		for i := 0; i < 1000; i++ {
+			cpf := GenerateCPF(nil)
+			assert.Len(t, cpf, cpfLength)
+			assert.True(t, IsValidCPF(cpf), cpf)
+		}
+	})
+
+	t.Run("same seed generates the same documents", func(t *testing.T) {
+		first := rand.New(rand.NewPCG(1, 2))
+		second := rand.New(rand.NewPCG(1, 2))
+
+		for i := 0; i < 10; i++ {
+			assert.Equal(t, GenerateCPF(first), GenerateCPF(second))
+		}
+	})
+}
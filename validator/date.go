@@ -0,0 +1,122 @@
+package validator
+
+import (
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/diegoclair/go_utils/dateutils"
+	"github.com/go-playground/validator/v10"
+)
+
+// dateLayouts are the layouts of the string dates accepted by the rules past, future, min_age, max_age and within_days
+var dateLayouts = []string{dateutils.OnlyDateLayout, dateutils.APIDateLayout}
+
+// dateValidations returns the tags of the dates, evaluated against the clock of the validator (see WithClock).
+// The fields can be strings, in the layouts of dateutils, or time.Time.
+// The rules that compare days use the date in UTC, the time is ignored.
+func (v *validatorImpl) dateValidations() map[string]validator.Func {
+	return map[string]validator.Func{
+		"date": func(fl validator.FieldLevel) bool {
+			return validDateString(fl.Field(), dateutils.OnlyDateLayout)
+		},
+		"datetime_api": func(fl validator.FieldLevel) bool {
+			return validDateString(fl.Field(), dateutils.APIDateLayout)
+		},
+		// past accepts the dates that are not in the future, including today for the dates without time
+		"past": func(fl validator.FieldLevel) bool {
+			date, onlyDate, ok := fieldDate(fl.Field())
+			if !ok {
+				return false
+			}
+
+			if onlyDate {
+				return !date.After(truncateDay(v.now()))
+			}
+			return !date.After(v.now())
+		},
+		// future accepts the dates that are not in the past, including today for the dates without time
+		"future": func(fl validator.FieldLevel) bool {
+			date, onlyDate, ok := fieldDate(fl.Field())
+			if !ok {
+				return false
+			}
+
+			if onlyDate {
+				return !date.Before(truncateDay(v.now()))
+			}
+			return !date.Before(v.now())
+		},
+		// min_age checks that the birth date is of someone at least param years old, e.g. min_age=18
+		"min_age": func(fl validator.FieldLevel) bool {
+			years, err := strconv.Atoi(fl.Param())
+			birthDate, _, ok := fieldDate(fl.Field())
+			return ok && err == nil && age(birthDate, v.now()) >= years
+		},
+		// max_age checks that the birth date is of someone at most param years old, e.g. max_age=120
+		"max_age": func(fl validator.FieldLevel) bool {
+			years, err := strconv.Atoi(fl.Param())
+			birthDate, _, ok := fieldDate(fl.Field())
+			return ok && err == nil && !birthDate.After(v.now()) && age(birthDate, v.now()) <= years
+		},
+		// within_days checks that the date is at most param days before or after today, e.g. within_days=90
+		"within_days": func(fl validator.FieldLevel) bool {
+			days, err := strconv.Atoi(fl.Param())
+			date, _, ok := fieldDate(fl.Field())
+			if !ok || err != nil {
+				return false
+			}
+
+			today := truncateDay(v.now())
+			date = truncateDay(date)
+			return !date.Before(today.AddDate(0, 0, -days)) && !date.After(today.AddDate(0, 0, days))
+		},
+	}
+}
+
+// validDateString reports whether the field is a string with a date in the layout
+func validDateString(field reflect.Value, layout string) bool {
+	if field.Kind() != reflect.String {
+		return false
+	}
+
+	_, err := time.Parse(layout, field.String())
+	return err == nil
+}
+
+// fieldDate returns the date of a field with a time.Time or a string in one of the dateLayouts,
+// and whether the date has no time, which is the case of the strings in the dateutils.OnlyDateLayout
+func fieldDate(field reflect.Value) (date time.Time, onlyDate bool, ok bool) {
+	switch {
+	case field.Type() == reflect.TypeFor[time.Time]():
+		date = field.Interface().(time.Time)
+		return date, false, !date.IsZero()
+
+	case field.Kind() == reflect.String:
+		for _, layout := range dateLayouts {
+			date, err := time.Parse(layout, field.String())
+			if err == nil {
+				return date, layout == dateutils.OnlyDateLayout, true
+			}
+		}
+	}
+
+	return time.Time{}, false, false
+}
+
+// truncateDay returns the start of the day of the date in UTC
+func truncateDay(date time.Time) time.Time {
+	year, month, day := date.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// age returns the completed years of someone born on the birth date, on the day of now
+func age(birthDate, now time.Time) int {
+	birthDate, now = birthDate.UTC(), now.UTC()
+
+	years := now.Year() - birthDate.Year()
+	if now.Month() < birthDate.Month() || (now.Month() == birthDate.Month() && now.Day() < birthDate.Day()) {
+		years--
+	}
+	return years
+}
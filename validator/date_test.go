@@ -0,0 +1,88 @@
+package validator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validatorImpl_ValidateStruct_Dates(t *testing.T) {
+	now := time.Date(2026, time.October, 19, 15, 30, 0, 0, time.UTC)
+
+	v, err := NewValidator(WithClock(func() time.Time { return now }))
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		value any
+		tag   string
+		want  bool
+	}{
+		{name: "date", value: "2026-02-28", tag: "date", want: true},
+		{name: "date with time", value: "2026-02-28T10:00:00Z", tag: "date", want: false},
+		{name: "invalid date", value: "2026-02-30", tag: "date", want: false},
+		{name: "date of another layout", value: "28/02/2026", tag: "date", want: false},
+		{name: "datetime_api", value: "2026-02-28T10:00:00Z", tag: "datetime_api", want: true},
+		{name: "datetime_api without time", value: "2026-02-28", tag: "datetime_api", want: false},
+		{name: "datetime_api with offset", value: "2026-02-28T10:00:00-03:00", tag: "datetime_api", want: false},
+
+		{name: "past date", value: "2026-10-18", tag: "past", want: true},
+		{name: "past accepts today", value: "2026-10-19", tag: "past", want: true},
+		{name: "past rejects tomorrow", value: "2026-10-20", tag: "past", want: false},
+		{name: "past datetime", value: "2026-10-19T15:00:00Z", tag: "past", want: true},
+		{name: "past rejects a later time of today", value: "2026-10-19T16:00:00Z", tag: "past", want: false},
+		{name: "past time.Time", value: now.Add(-time.Hour), tag: "past", want: true},
+		{name: "past rejects the zero time.Time", value: time.Time{}, tag: "past", want: false},
+		{name: "past rejects invalid dates", value: "yesterday", tag: "past", want: false},
+
+		{name: "future date", value: "2026-10-20", tag: "future", want: true},
+		{name: "future accepts today", value: "2026-10-19", tag: "future", want: true},
+		{name: "future rejects yesterday", value: "2026-10-18", tag: "future", want: false},
+		{name: "future rejects an earlier time of today", value: "2026-10-19T15:00:00Z", tag: "future", want: false},
+		{name: "future time.Time", value: now.Add(time.Hour), tag: "future", want: true},
+
+		{name: "min_age on the birthday", value: "2008-10-19", tag: "min_age=18", want: true},
+		{name: "min_age the day before the birthday", value: "2008-10-20", tag: "min_age=18", want: false},
+		{name: "min_age datetime", value: "2000-01-01T00:00:00Z", tag: "min_age=18", want: true},
+		{name: "min_age invalid param", value: "2000-01-01", tag: "min_age=adult", want: false},
+		{name: "max_age", value: "1926-10-20", tag: "max_age=99", want: true},
+		{name: "max_age exceeded", value: "1926-10-19", tag: "max_age=99", want: false},
+		{name: "max_age rejects future dates", value: "2026-10-20", tag: "max_age=99", want: false},
+
+		{name: "within_days before", value: "2026-07-21", tag: "within_days=90", want: true},
+		{name: "within_days after", value: "2027-01-17", tag: "within_days=90", want: true},
+		{name: "within_days too old", value: "2026-07-20", tag: "within_days=90", want: false},
+		{name: "within_days too far", value: "2027-01-18", tag: "within_days=90", want: false},
+		{name: "within_days ignores the time", value: "2027-01-17T23:59:59Z", tag: "within_days=90", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Var(tt.value, tt.tag)
+			assert.Equal(t, tt.want, err == nil)
+		})
+	}
+
+	t.Run("messages", func(t *testing.T) {
+		type person struct {
+			BirthDate   string `json:"birth_date" validate:"date,past,min_age=18"`
+			ScheduledTo string `json:"scheduled_to" validate:"datetime_api,future,within_days=90"`
+		}
+
+		err := v.ValidateStruct(ContextWithLocale(context.Background(), LocalePTBR), person{BirthDate: "2010-05-10", ScheduledTo: "2027-05-10T10:00:00Z"})
+		assert.Equal(t, []FieldError{
+			{Field: "birth_date", Tag: "min_age", Param: "18", Value: "2010-05-10", Message: "O campo 'birth_date' deve ser a data de nascimento de alguém com pelo menos 18 anos"},
+			{Field: "scheduled_to", Tag: "within_days", Param: "90", Value: "2027-05-10T10:00:00Z", Message: "O campo 'scheduled_to' deve ser uma data de até 90 dias de hoje"},
+		}, FieldErrors(err))
+	})
+}
+
+func Test_age(t *testing.T) {
+	now := time.Date(2026, time.February, 28, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 18, age(time.Date(2008, time.February, 28, 0, 0, 0, 0, time.UTC), now))
+	assert.Equal(t, 17, age(time.Date(2008, time.February, 29, 0, 0, 0, 0, time.UTC), now))
+	assert.Equal(t, 0, age(time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC), now))
+}
@@ -0,0 +1,261 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Decimal rule codes, reported in FieldError.Code by the decimal and money tags
+const (
+	DecimalScale = "decimal_scale"
+	DecimalMin   = "decimal_min"
+	DecimalMax   = "decimal_max"
+)
+
+const (
+	// moneyScale is the default scale of the money tag, the cents
+	moneyScale = 2
+	// maxFormatScale is the max number of decimal places of the limits in the messages
+	maxFormatScale = 30
+)
+
+var (
+	// decimalRegexes are the formats of the decimal numbers by locale, the empty locale is the canonical format, e.g. 1234.56
+	decimalRegexes = map[string]*regexp.Regexp{
+		"":         regexp.MustCompile(`^[+-]?[0-9]+(\.[0-9]+)?$`),
+		LocaleEN:   regexp.MustCompile(`^[+-]?([0-9]{1,3}(,[0-9]{3})+|[0-9]+)(\.[0-9]+)?$`),
+		LocalePTBR: regexp.MustCompile(`^[+-]?([0-9]{1,3}(\.[0-9]{3})+|[0-9]+)(,[0-9]+)?$`),
+	}
+
+	// decimalSeparators are the thousands and decimal separators by locale
+	decimalSeparators = map[string][2]string{
+		"":         {"", "."},
+		LocaleEN:   {",", "."},
+		LocalePTBR: {".", ","},
+	}
+
+	// currencySymbols are the symbols accepted before the amounts of money, the longest first
+	currencySymbols = []string{"R$", "US$", "$"}
+)
+
+// ParseDecimal parses a decimal number in the format of the locale and returns its exact value and scale,
+// the number of digits after the decimal separator. The empty locale is the canonical format, e.g. 1234.56,
+// en has the thousands separated by comma, e.g. 1,234.56, and pt-BR by dot, e.g. 1.234,56.
+// It returns ok false if the value is not in the format of the locale or the locale is not supported.
+func ParseDecimal(value, locale string) (amount *big.Rat, scale int, ok bool) {
+	locale = normalizeLocale(locale)
+	regex, found := decimalRegexes[locale]
+	if !found {
+		return nil, 0, false
+	}
+
+	value = strings.TrimSpace(value)
+	if !regex.MatchString(value) {
+		return nil, 0, false
+	}
+
+	separators := decimalSeparators[locale]
+	if separators[0] != "" {
+		value = strings.ReplaceAll(value, separators[0], "")
+	}
+	integer, fraction, _ := strings.Cut(value, separators[1])
+
+	amount, ok = new(big.Rat).SetString(integer + "." + fraction)
+	if !ok {
+		return nil, 0, false
+	}
+	return amount, len(fraction), true
+}
+
+// ParseMoney parses an amount of money like ParseDecimal, accepting a currency symbol (R$, US$ or $)
+// before the number, e.g. R$ 1.234,56 or -$ 10.00
+func ParseMoney(value, locale string) (amount *big.Rat, scale int, ok bool) {
+	value = strings.TrimSpace(value)
+
+	// the sign can be before the symbol, e.g. -R$ 10,00
+	sign := ""
+	if strings.HasPrefix(value, "-") || strings.HasPrefix(value, "+") {
+		sign, value = value[:1], strings.TrimSpace(value[1:])
+	}
+
+	for _, symbol := range currencySymbols {
+		if rest, found := strings.CutPrefix(value, symbol); found {
+			value = strings.TrimSpace(rest)
+			break
+		}
+	}
+
+	if sign != "" && (strings.HasPrefix(value, "-") || strings.HasPrefix(value, "+")) {
+		return nil, 0, false
+	}
+	return ParseDecimal(sign+value, locale)
+}
+
+// decimalParams are the params of the decimal and money tags, e.g. decimal=scale:2 min:0 max:Limit locale:pt-BR
+type decimalParams struct {
+	// scale is the max number of digits after the decimal separator, -1 for any
+	scale int
+	// min and max are decimal numbers in the canonical format or the names of sibling fields, empty for no limit
+	min string
+	max string
+	// locale is the format of the value and of the string sibling fields, the canonical format when empty
+	locale string
+}
+
+func parseDecimalParams(param string, defaultScale int) (decimalParams, error) {
+	params := decimalParams{scale: defaultScale}
+	for _, item := range paramList(param) {
+		key, value, found := strings.Cut(item, ":")
+		if !found || value == "" {
+			return decimalParams{}, fmt.Errorf("%q should be key:value", item)
+		}
+
+		switch key {
+		case "scale":
+			scale, err := strconv.Atoi(value)
+			if err != nil || scale < 0 {
+				return decimalParams{}, fmt.Errorf("the scale %q should be a number not negative", value)
+			}
+			params.scale = scale
+		case "min":
+			params.min = value
+		case "max":
+			params.max = value
+		case "locale":
+			if _, ok := decimalRegexes[normalizeLocale(value)]; !ok {
+				return decimalParams{}, fmt.Errorf("the locale %q is not supported", value)
+			}
+			params.locale = value
+		default:
+			return decimalParams{}, fmt.Errorf("the key %q is unknown", key)
+		}
+	}
+	return params, nil
+}
+
+// validDecimal checks a decimal number in a string field, see ParseDecimal
+func validDecimal(ctx context.Context, fl validator.FieldLevel) bool {
+	return validAmount(ctx, fl, "decimal", ParseDecimal, -1)
+}
+
+// validMoney checks an amount of money in a string field, with 2 decimal places by default, see ParseMoney
+func validMoney(ctx context.Context, fl validator.FieldLevel) bool {
+	return validAmount(ctx, fl, "money", ParseMoney, moneyScale)
+}
+
+// validAmount parses the field with parse and checks the scale and the limits of the params, registering
+// the failed rules in the validation state, so each one is reported as its own FieldError.
+// The comparisons are exact, with big.Rat.
+func validAmount(ctx context.Context, fl validator.FieldLevel, tag string, parse func(value, locale string) (*big.Rat, int, bool), defaultScale int) bool {
+	if fl.Field().Kind() != reflect.String {
+		return false
+	}
+
+	// a malformed param is a mistake in the tag and not an invalid value, so it panics as go-playground does
+	// with the tags used wrongly
+	params, err := parseDecimalParams(fl.Param(), defaultScale)
+	if err != nil {
+		panic(fmt.Sprintf("invalid param %q of the %s tag: %v", fl.Param(), tag, err))
+	}
+
+	amount, scale, ok := parse(fl.Field().String(), params.locale)
+	if !ok {
+		return false
+	}
+
+	var failed []failedRule
+	if params.scale >= 0 && scale > params.scale {
+		failed = append(failed, failedRule{code: DecimalScale, param: strconv.Itoa(params.scale)})
+	}
+
+	if params.min != "" {
+		limit, found, ok := decimalLimit(fl, tag, params.min, params.locale, parse)
+		if !ok {
+			return false
+		}
+		if found && amount.Cmp(limit) < 0 {
+			failed = append(failed, failedRule{code: DecimalMin, param: formatDecimal(limit)})
+		}
+	}
+
+	if params.max != "" {
+		limit, found, ok := decimalLimit(fl, tag, params.max, params.locale, parse)
+		if !ok {
+			return false
+		}
+		if found && amount.Cmp(limit) > 0 {
+			failed = append(failed, failedRule{code: DecimalMax, param: formatDecimal(limit)})
+		}
+	}
+
+	if len(failed) == 0 {
+		return true
+	}
+
+	if state := validationStateFromContext(ctx); state != nil {
+		state.addRuleFailure(tag, fl.StructFieldName(), failed)
+	}
+	return false
+}
+
+// decimalLimit returns the value of a limit, a decimal number in the canonical format or the name of a sibling field
+// with a number or a string in the format of the locale. found is false when the sibling field is an empty string,
+// which means no limit, and ok is false when the sibling field is not a valid number.
+// It panics when the limit is neither a number nor a sibling field, like the malformed params.
+func decimalLimit(fl validator.FieldLevel, tag, limit, locale string, parse func(value, locale string) (*big.Rat, int, bool)) (value *big.Rat, found, ok bool) {
+	if value, _, ok := ParseDecimal(limit, ""); ok {
+		return value, true, true
+	}
+
+	// the values validated without a struct, e.g. by Var or ValidateMap, have no sibling fields
+	if fl.Parent().Kind() != reflect.Struct {
+		panic(fmt.Sprintf("invalid limit %q of the %s tag: it should be a number, sibling fields need a struct", limit, tag))
+	}
+
+	field, kind, _, ok := fl.GetStructFieldOKAdvanced2(fl.Parent(), limit)
+	if !ok {
+		panic(fmt.Sprintf("invalid limit %q of the %s tag: it should be a number or a sibling field", limit, tag))
+	}
+
+	switch kind {
+	case reflect.String:
+		if field.String() == "" {
+			return nil, false, true
+		}
+		value, _, ok = parse(field.String(), locale)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value, ok = new(big.Rat).SetInt64(field.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		value, ok = new(big.Rat).SetInt(new(big.Int).SetUint64(field.Uint())), true
+	case reflect.Float32, reflect.Float64:
+		// the shortest decimal of the float, so 0.1 is 1/10 and not its binary approximation
+		value, ok = new(big.Rat).SetString(strconv.FormatFloat(field.Float(), 'f', -1, field.Type().Bits()))
+	default:
+		ok = false
+	}
+
+	return value, ok, ok
+}
+
+// formatDecimal returns the value in the canonical format with the digits it needs, e.g. 1234.5
+func formatDecimal(value *big.Rat) string {
+	if value.IsInt() {
+		return value.RatString()
+	}
+
+	// the values parsed from decimal numbers have a finite expansion
+	for prec := 1; prec <= maxFormatScale; prec++ {
+		formatted := value.FloatString(prec)
+		if parsed, ok := new(big.Rat).SetString(formatted); ok && parsed.Cmp(value) == 0 {
+			return formatted
+		}
+	}
+	return value.FloatString(maxFormatScale)
+}
@@ -0,0 +1,198 @@
+package validator
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		locale    string
+		wantValue string
+		wantScale int
+		wantOk    bool
+	}{
+		{name: "canonical", value: "1234.56", wantValue: "30864/25", wantScale: 2, wantOk: true},
+		{name: "canonical integer", value: "-42", wantValue: "-42", wantScale: 0, wantOk: true},
+		{name: "canonical keeps the trailing zeros in the scale", value: "10.500", wantValue: "21/2", wantScale: 3, wantOk: true},
+		{name: "canonical with thousands separator", value: "1,234.56", wantOk: false},
+		{name: "en with thousands separator", value: "1,234.56", locale: LocaleEN, wantValue: "30864/25", wantScale: 2, wantOk: true},
+		{name: "en without thousands separator", value: "1234.56", locale: LocaleEN, wantValue: "30864/25", wantScale: 2, wantOk: true},
+		{name: "en with misplaced thousands separator", value: "12,34.56", locale: LocaleEN, wantOk: false},
+		{name: "pt-BR with thousands separator", value: "1.234,56", locale: LocalePTBR, wantValue: "30864/25", wantScale: 2, wantOk: true},
+		{name: "pt-BR without thousands separator", value: "-1234,5", locale: "pt_br", wantValue: "-2469/2", wantScale: 1, wantOk: true},
+		{name: "pt-BR with dot as decimal separator", value: "1234.56", locale: LocalePTBR, wantOk: false},
+		{name: "unsupported locale", value: "1234.56", locale: "fr", wantOk: false},
+		{name: "exponent", value: "1e3", wantOk: false},
+		{name: "fraction without integer", value: ".5", wantOk: false},
+		{name: "empty", value: "", wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, scale, ok := ParseDecimal(tt.value, tt.locale)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.wantScale, scale)
+			if tt.wantOk {
+				assert.Equal(t, tt.wantValue, value.RatString())
+			}
+		})
+	}
+}
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		value     string
+		locale    string
+		wantValue string
+		wantOk    bool
+	}{
+		{value: "R$ 1.234,56", locale: LocalePTBR, wantValue: "30864/25", wantOk: true},
+		{value: "-R$ 10,00", locale: LocalePTBR, wantValue: "-10", wantOk: true},
+		{value: "R$-10,00", locale: LocalePTBR, wantValue: "-10", wantOk: true},
+		{value: "US$ 1,000.10", locale: LocaleEN, wantValue: "10001/10", wantOk: true},
+		{value: "$0.99", wantValue: "99/100", wantOk: true},
+		{value: "19.90", wantValue: "199/10", wantOk: true},
+		{value: "--R$ 10,00", locale: LocalePTBR, wantOk: false},
+		{value: "-R$ -10,00", locale: LocalePTBR, wantOk: false},
+		{value: "€ 10.00", wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			value, _, ok := ParseMoney(tt.value, tt.locale)
+			assert.Equal(t, tt.wantOk, ok)
+			if tt.wantOk {
+				assert.Equal(t, tt.wantValue, value.RatString())
+			}
+		})
+	}
+}
+
+func Test_validatorImpl_ValidateStruct_Decimal(t *testing.T) {
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	type payment struct {
+		Rate    string  `json:"rate" validate:"omitempty,decimal=scale:4 min:0 max:1"`
+		Amount  string  `json:"amount" validate:"omitempty,money=min:0.01 max:Limit locale:pt-BR"`
+		Limit   string  `json:"limit" validate:"omitempty,money=locale:pt-BR"`
+		Fee     string  `json:"fee" validate:"omitempty,money=max:MaxFee"`
+		MaxFee  float64 `json:"max_fee"`
+		Balance string  `json:"balance" validate:"omitempty,decimal=min:-100.5"`
+	}
+
+	tests := []struct {
+		name    string
+		dataSet payment
+		wantErr []FieldError
+	}{
+		{
+			name:    "should accept the values in the limits",
+			dataSet: payment{Rate: "0.1234", Amount: "R$ 1.500,00", Limit: "1.500,00", Fee: "0.30", MaxFee: 0.3, Balance: "-100.50"},
+		},
+		{
+			name:    "should accept any amount when the limit field is empty",
+			dataSet: payment{Amount: "1.000.000,00"},
+		},
+		{
+			name:    "should compare exactly, without float rounding",
+			dataSet: payment{Rate: "1.0001", Balance: "-100.5000000000000000001"},
+			wantErr: []FieldError{
+				{Field: "rate", Tag: "decimal", Code: DecimalMax, Param: "1", Value: "1.0001", Message: "The field 'rate' should be at most 1"},
+				{Field: "balance", Tag: "decimal", Code: DecimalMin, Param: "-100.5", Value: "-100.5000000000000000001", Message: "The field 'balance' should be at least -100.5"},
+			},
+		},
+		{
+			name:    "should report each failed rule",
+			dataSet: payment{Rate: "-0.00001"},
+			wantErr: []FieldError{
+				{Field: "rate", Tag: "decimal", Code: DecimalScale, Param: "4", Value: "-0.00001", Message: "The field 'rate' should have at most 4 decimal places"},
+				{Field: "rate", Tag: "decimal", Code: DecimalMin, Param: "0", Value: "-0.00001", Message: "The field 'rate' should be at least 0"},
+			},
+		},
+		{
+			name:    "should compare with the sibling fields",
+			dataSet: payment{Amount: "1.500,01", Limit: "1.500,00", Fee: "0.31", MaxFee: 0.3},
+			wantErr: []FieldError{
+				{Field: "amount", Tag: "money", Code: DecimalMax, Param: "1500", Value: "1.500,01", Message: "The field 'amount' should be at most 1500"},
+				{Field: "fee", Tag: "money", Code: DecimalMax, Param: "0.3", Value: "0.31", Message: "The field 'fee' should be at most 0.3"},
+			},
+		},
+		{
+			name:    "should reject the money with more than 2 decimal places",
+			dataSet: payment{Fee: "0.305", MaxFee: 1},
+			wantErr: []FieldError{
+				{Field: "fee", Tag: "money", Code: DecimalScale, Param: "2", Value: "0.305", Message: "The field 'fee' should have at most 2 decimal places"},
+			},
+		},
+		{
+			name:    "should reject a value out of the format of the locale",
+			dataSet: payment{Amount: "1,500.00", Rate: "abc"},
+			wantErr: []FieldError{
+				{Field: "rate", Tag: "decimal", Param: "scale:4 min:0 max:1", Value: "abc", Message: "The field 'rate' should be a valid decimal number"},
+				{Field: "amount", Tag: "money", Param: "min:0.01 max:Limit locale:pt-BR", Value: "1,500.00", Message: "The field 'amount' should be a valid amount of money"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.ValidateStruct(context.Background(), tt.dataSet)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tt.wantErr, FieldErrors(err))
+		})
+	}
+}
+
+func Test_validatorImpl_Var_Decimal(t *testing.T) {
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	assert.NoError(t, v.Var("10.50", "decimal=scale:2 min:10"))
+	assert.Error(t, v.Var("9.99", "decimal=min:10"))
+	assert.Error(t, v.Var(10.5, "decimal"), "not a string")
+}
+
+func Test_validatorImpl_Decimal_invalidParams(t *testing.T) {
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	for _, tag := range []string{
+		"decimal=precision:2",
+		"decimal=scale",
+		"decimal=scale:-1",
+		"money=locale:fr",
+		"decimal=max:Limit",
+	} {
+		assert.Panics(t, func() { _ = v.Var("10", tag) }, tag)
+	}
+
+	type transfer struct {
+		Amount string `json:"amount" validate:"money=max:Balance"`
+	}
+	assert.PanicsWithValue(t, `invalid limit "Balance" of the money tag: it should be a number or a sibling field`, func() {
+		_ = v.ValidateStruct(context.Background(), transfer{Amount: "10"})
+	})
+
+	err = v.ValidateMap(context.Background(), map[string]any{"amount": "10"}, map[string]any{"amount": "decimal=min:one"})
+	restErr, ok := err.(resterrors.RestErr)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusInternalServerError, restErr.StatusCode())
+}
+
+func Test_formatDecimal(t *testing.T) {
+	assert.Equal(t, "1500", formatDecimal(big.NewRat(1500, 1)))
+	assert.Equal(t, "-0.05", formatDecimal(big.NewRat(-1, 20)))
+	assert.Equal(t, "0."+"333333333333333333333333333333", formatDecimal(big.NewRat(1, 3)))
+}
@@ -0,0 +1,117 @@
+// Package echovalidator plugs the validator package into echo, so the custom tags, the normalize tags
+// and the resterrors error format are used by c.Validate.
+//
+//	e.Validator = echovalidator.New(v)
+//	e.HTTPErrorHandler = echovalidator.ErrorHandler(e.DefaultHTTPErrorHandler)
+package echovalidator
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/go_utils/validator"
+	"github.com/labstack/echo/v4"
+)
+
+type structValidator struct {
+	validator validator.Validator
+}
+
+// New returns an echo.Validator that validates with the given validator.
+// The normalize tags are applied before the validation when c.Validate receives a pointer.
+// Slices and arrays of structs are validated with ValidateSlice, whose errors have the index of the invalid items.
+//
+// The echo interface does not receive the request context, so c.Validate validates with context.Background():
+// use Validate of this package to validate with the request context.
+func New(v validator.Validator) echo.Validator {
+	return &structValidator{validator: v}
+}
+
+func (s *structValidator) Validate(i any) error {
+	return s.validate(context.Background(), i)
+}
+
+// Validate validates i like c.Validate, but with the request context, so the locale of validator.ContextWithLocale
+// and the cancellation of the request reach the validator. It needs e.Validator set with New,
+// otherwise it is the same as c.Validate.
+func Validate(c echo.Context, i any) error {
+	s, ok := c.Echo().Validator.(*structValidator)
+	if !ok {
+		return c.Validate(i)
+	}
+	return s.validate(c.Request().Context(), i)
+}
+
+func (s *structValidator) validate(ctx context.Context, i any) error {
+	value := reflect.ValueOf(i)
+
+	// the slices and arrays of structs are validated with ValidateSlice, whose errors have the index
+	// of the invalid items (see validator.RowErrors), as ginvalidator does
+	if elems := reflect.Indirect(value); (elems.Kind() == reflect.Slice || elems.Kind() == reflect.Array) &&
+		indirectType(elems.Type().Elem()).Kind() == reflect.Struct {
+		for i := 0; i < elems.Len(); i++ {
+			elem := elems.Index(i)
+			if elem.Kind() != reflect.Ptr && elem.CanAddr() {
+				elem = elem.Addr()
+			}
+
+			err := s.normalize(ctx, elem)
+			if err != nil {
+				return err
+			}
+		}
+
+		return s.validator.ValidateSlice(ctx, elems.Interface())
+	}
+
+	err := s.normalize(ctx, value)
+	if err != nil {
+		return err
+	}
+
+	return s.validator.ValidateStruct(ctx, i)
+}
+
+// normalize applies the normalize tags when the value is a pointer to a struct
+func (s *structValidator) normalize(ctx context.Context, value reflect.Value) error {
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	return s.validator.Normalize(ctx, value.Interface())
+}
+
+func indirectType(typ reflect.Type) reflect.Type {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return typ
+}
+
+// ErrorHandler returns an echo.HTTPErrorHandler that renders the resterrors.RestErr errors
+// with their status code and their JSON as body, and calls next for the other errors,
+// usually the e.DefaultHTTPErrorHandler.
+func ErrorHandler(next echo.HTTPErrorHandler) echo.HTTPErrorHandler {
+	return func(err error, c echo.Context) {
+		var restErr resterrors.RestErr
+		if !errors.As(err, &restErr) {
+			next(err, c)
+			return
+		}
+
+		if c.Response().Committed {
+			return
+		}
+
+		if c.Request().Method == http.MethodHead {
+			err = c.NoContent(restErr.StatusCode())
+		} else {
+			err = c.JSON(restErr.StatusCode(), restErr)
+		}
+		if err != nil {
+			c.Logger().Error(err)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package echovalidator
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/diegoclair/go_utils/validator"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+type userInput struct {
+	Name string `json:"name" normalize:"trim" validate:"required_trim"`
+	CPF  string `json:"cpf" normalize:"unmask_cpf" validate:"required,cpf"`
+}
+
+func TestStructValidator_Validate(t *testing.T) {
+	v, err := validator.NewValidator()
+	assert.NoError(t, err)
+	ev := New(v)
+
+	t.Run("normalizes and validates a pointer to struct", func(t *testing.T) {
+		input := &userInput{Name: " John ", CPF: "529.982.247-25"}
+		assert.NoError(t, ev.Validate(input))
+		assert.Equal(t, &userInput{Name: "John", CPF: "52998224725"}, input)
+	})
+
+	t.Run("returns the field errors", func(t *testing.T) {
+		err := ev.Validate(userInput{Name: " ", CPF: "123"})
+		assert.Equal(t, []validator.FieldError{
+			{Field: "name", Tag: "required_trim", Value: " ", Message: "The field 'name' is required"},
+			{Field: "cpf", Tag: "cpf", Value: validator.RedactedValue, Message: "The field 'cpf' should be a valid cpf"},
+		}, validator.FieldErrors(err))
+	})
+
+	t.Run("validates each element of a slice", func(t *testing.T) {
+		inputs := []userInput{{Name: " John ", CPF: "529.982.247-25"}, {Name: "Mary", CPF: "123"}}
+		err := ev.Validate(&inputs)
+		assert.Equal(t, []validator.RowError{
+			{Row: 1, Errors: []validator.FieldError{
+				{Field: "[1].cpf", Tag: "cpf", Value: validator.RedactedValue, Message: "The field '[1].cpf' should be a valid cpf"},
+			}},
+		}, validator.RowErrors(err))
+		assert.Equal(t, "John", inputs[0].Name)
+
+		assert.NoError(t, ev.Validate(&[]*userInput{{Name: "Ana", CPF: "52998224725"}}))
+	})
+}
+
+func TestErrorHandler(t *testing.T) {
+	v, err := validator.NewValidator()
+	assert.NoError(t, err)
+
+	e := echo.New()
+	e.Validator = New(v)
+	e.HTTPErrorHandler = ErrorHandler(e.DefaultHTTPErrorHandler)
+	e.POST("/users", func(c echo.Context) error {
+		input := userInput{}
+		if err := c.Bind(&input); err != nil {
+			return err
+		}
+		if err := c.Validate(&input); err != nil {
+			return err
+		}
+		return c.JSON(http.StatusCreated, input)
+	})
+	e.POST("/users/pt-br", func(c echo.Context) error {
+		c.SetRequest(c.Request().WithContext(validator.ContextWithLocale(c.Request().Context(), validator.LocalePTBR)))
+
+		input := userInput{}
+		if err := c.Bind(&input); err != nil {
+			return err
+		}
+		if err := Validate(c, &input); err != nil {
+			return err
+		}
+		return c.JSON(http.StatusCreated, input)
+	})
+
+	tests := []struct {
+		name       string
+		target     string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "valid input",
+			target:     "/users",
+			body:       `{"name": " John ", "cpf": "529.982.247-25"}`,
+			wantStatus: http.StatusCreated,
+			wantBody:   `{"name": "John", "cpf": "52998224725"}`,
+		},
+		{
+			name:       "renders the validation error",
+			target:     "/users",
+			body:       `{"name": "John"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody: `{"message": "Invalid input data", "status_code": 422, "error": "Unprocessable Entity", "causes": [[
+				{"field": "cpf", "tag": "required", "message": "The field 'cpf' is required"}
+			]]}`,
+		},
+		{
+			name:       "validates with the request context",
+			target:     "/users/pt-br",
+			body:       `{"name": "John"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody: `{"message": "Invalid input data", "status_code": 422, "error": "Unprocessable Entity", "causes": [[
+				{"field": "cpf", "tag": "required", "message": "O campo 'cpf' é obrigatório"}
+			]]}`,
+		},
+		{
+			name:       "other errors use the next handler",
+			target:     "/unknown",
+			wantStatus: http.StatusNotFound,
+			wantBody:   `{"message": "Not Found"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, tt.target, strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			assert.JSONEq(t, tt.wantBody, rec.Body.String())
+		})
+	}
+}
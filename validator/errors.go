@@ -0,0 +1,306 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/go-playground/validator/v10"
+)
+
+// FieldError is the structured representation of a single validation failure.
+// ValidateStruct returns a resterrors.RestErr whose causes are a list of FieldError.
+type FieldError struct {
+	// Field is the full path of the field as the client sent it, e.g. items[2].address.zip_code
+	Field string `json:"field"`
+	// Tag is the validation tag that failed, e.g. required, cpf
+	Tag string `json:"tag"`
+	// Code is the rule that failed when the tag checks several rules, e.g. password_min_length for the tag password.
+	// Each failed rule is reported as its own FieldError.
+	Code string `json:"code,omitempty"`
+	// Param is the parameter of the failed tag, if any, e.g. 18 for min=18
+	Param string `json:"param,omitempty"`
+	// Value is the value of the field when it is a non empty string, a number or a boolean.
+	// It is RedactedValue for the sensitive fields, see RegisterSensitiveTags.
+	Value any `json:"value,omitempty"`
+	// Message is the human readable description of the failure
+	Message string `json:"message"`
+}
+
+// String returns the message of the error, so the RestErr Error() keeps printing readable messages
+func (e FieldError) String() string {
+	return e.Message
+}
+
+// FieldErrors returns the list of FieldError of an error returned by ValidateStruct or Bind,
+// or nil when the error has no field errors. The errors of ValidateSlice are the ones of all the rows,
+// which have the index of the row in the path, e.g. [17].cpf, see RowErrors.
+func FieldErrors(err error) []FieldError {
+	var restErr resterrors.RestErr
+	if !errors.As(err, &restErr) {
+		return nil
+	}
+
+	causes, _ := restErr.Causes().([]any)
+	for _, cause := range causes {
+		switch cause := cause.(type) {
+		case []FieldError:
+			return cause
+		case []RowError:
+			return flattenRowErrors(cause)
+		}
+	}
+
+	return nil
+}
+
+// invalidInputError returns the unprocessable entity error of the invalid fields, with the list of FieldError
+// as causes, or the list of their messages with WithMessageCauses
+func (v *validatorImpl) invalidInputError(fieldErrors []FieldError) error {
+	return resterrors.NewUnprocessableEntity("Invalid input data", fieldErrorCauses(fieldErrors, v.messageCauses))
+}
+
+// fieldErrorCauses returns the causes of the errors of invalid fields: the list of FieldError,
+// or the list of their messages when messageCauses is set (see WithMessageCauses)
+func fieldErrorCauses(fieldErrors []FieldError, messageCauses bool) any {
+	if !messageCauses {
+		return fieldErrors
+	}
+
+	messages := make([]string, 0, len(fieldErrors))
+	for _, fieldErr := range fieldErrors {
+		messages = append(messages, fieldErr.Message)
+	}
+	return messages
+}
+
+// fieldNameTags are the struct tags, in order of precedence, used to name the fields in the errors
+var fieldNameTags = []string{"json", "form", "query"}
+
+// fieldName returns the name of the field as the client sends it, reading the json tag
+// and falling back to the form and query tags. It returns an empty string when none is set.
+func fieldName(fld reflect.StructField) string {
+	for _, key := range fieldNameTags {
+		name, _, _ := strings.Cut(fld.Tag.Get(key), ",")
+		if name != "" && name != "-" {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// newFieldErrors converts the go-playground errors to our FieldError, naming each field by its full path
+// after the prefix and building the messages in the locale from the context.
+func (v *validatorImpl) newFieldErrors(ctx context.Context, dataSet any, prefix string, errs validator.ValidationErrors) []FieldError {
+	top := indirectType(reflect.TypeOf(dataSet))
+	locale := v.locale(ctx)
+	state := validationStateFromContext(ctx)
+
+	fieldErrors := make([]FieldError, 0, len(errs))
+	for _, err := range errs {
+		path, parent := structNamespacePath(top, err.StructNamespace())
+		if path == "" {
+			path = err.Field()
+		}
+		path = prefix + path
+
+		param := fieldParam(top, parent, err.Tag(), err.Param())
+		value := fieldErrorValue(err.Value(), v.isSensitiveField(parent, err))
+
+		fieldErrors = v.appendFieldErrors(fieldErrors, state, locale, path, param, value, err)
+	}
+
+	return fieldErrors
+}
+
+// appendFieldErrors appends the FieldError of the go-playground error of the field at the path,
+// or one FieldError per failed rule when the tag checks several rules, like password
+func (v *validatorImpl) appendFieldErrors(fieldErrors []FieldError, state *validationState, locale, path, param string, value any, err validator.FieldError) []FieldError {
+	messageValue := ""
+	if value != nil {
+		messageValue = fmt.Sprint(value)
+	}
+
+	tag, param := pixKeyFieldTag(err.Tag(), param)
+
+	if state != nil {
+		if rules := state.takeRuleFailure(err.Tag(), err.StructField()); rules != nil {
+			for _, rule := range rules {
+				fieldErrors = append(fieldErrors, FieldError{
+					Field:   path,
+					Tag:     err.Tag(),
+					Code:    rule.code,
+					Param:   rule.param,
+					Value:   value,
+					Message: v.messages.MessageWithValue(locale, rule.code, path, rule.param, messageValue),
+				})
+			}
+			return fieldErrors
+		}
+	}
+
+	return append(fieldErrors, FieldError{
+		Field:   path,
+		Tag:     tag,
+		Param:   param,
+		Value:   value,
+		Message: v.messages.MessageWithValue(locale, tag, path, param, messageValue),
+	})
+}
+
+// structNamespacePath converts a struct namespace like User.Items[2].Address.ZipCode
+// into the path the client knows, like items[2].address.zip_code.
+// It also returns the type of the struct that holds the last field.
+func structNamespacePath(top reflect.Type, structNamespace string) (path string, parent reflect.Type) {
+	if structNamespace == "" {
+		return "", nil
+	}
+
+	segments := splitNamespace(structNamespace)
+
+	// the namespace of a named struct starts with the type name
+	if top != nil && top.Kind() == reflect.Struct && top.Name() != "" && len(segments) > 0 && segments[0] == top.Name() {
+		segments = segments[1:]
+	}
+
+	return relativePath(top, segments)
+}
+
+// relativePath walks the segments of a namespace starting at typ and returns the path of the client field names.
+func relativePath(typ reflect.Type, segments []string) (path string, parent reflect.Type) {
+	var sb strings.Builder
+
+	for _, segment := range segments {
+		name, index, _ := strings.Cut(segment, "[")
+		if index != "" {
+			index = "[" + index
+		}
+
+		parent = typ
+		if typ == nil || typ.Kind() != reflect.Struct {
+			appendPathSegment(&sb, name, index)
+			typ = nil
+			continue
+		}
+
+		fld, ok := typ.FieldByName(name)
+		if !ok {
+			appendPathSegment(&sb, name, index)
+			typ = nil
+			continue
+		}
+
+		typ = indirectType(fld.Type)
+		for i := strings.Count(index, "["); i > 0 && typ != nil; i-- {
+			switch typ.Kind() {
+			case reflect.Slice, reflect.Array, reflect.Map:
+				typ = indirectType(typ.Elem())
+			default:
+				typ = nil
+			}
+		}
+
+		// embedded structs without a name have their fields promoted, as encoding/json does
+		clientName := fieldName(fld)
+		if fld.Anonymous && clientName == "" && index == "" {
+			continue
+		}
+
+		if clientName == "" {
+			clientName = fld.Name
+		}
+		appendPathSegment(&sb, clientName, index)
+	}
+
+	return sb.String(), parent
+}
+
+func appendPathSegment(sb *strings.Builder, name, index string) {
+	if sb.Len() > 0 && name != "" {
+		sb.WriteByte('.')
+	}
+	sb.WriteString(name)
+	sb.WriteString(index)
+}
+
+// splitNamespace splits a namespace by the dots that are not inside brackets (map keys may contain dots).
+func splitNamespace(namespace string) []string {
+	var (
+		segments []string
+		depth    int
+		start    int
+	)
+
+	for i := 0; i < len(namespace); i++ {
+		switch namespace[i] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case '.':
+			if depth == 0 {
+				segments = append(segments, namespace[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	return append(segments, namespace[start:])
+}
+
+// siblingFieldTags are the tags whose param is a list of fields of the same struct
+var siblingFieldTags = map[string]bool{
+	"eqfield": true, "nefield": true, "gtfield": true, "gtefield": true, "ltfield": true, "ltefield": true,
+	"fieldcontains": true, "fieldexcludes": true,
+	"required_with": true, "required_with_all": true, "required_without": true, "required_without_all": true,
+	"excluded_with": true, "excluded_with_all": true, "excluded_without": true, "excluded_without_all": true,
+}
+
+// crossStructFieldTags are the tags whose param is the namespace of a field from the top level struct
+var crossStructFieldTags = map[string]bool{
+	"eqcsfield": true, "necsfield": true, "gtcsfield": true, "gtecsfield": true, "ltcsfield": true, "ltecsfield": true,
+}
+
+// conditionalFieldTags are the tags whose param is a list of field and value pairs
+var conditionalFieldTags = map[string]bool{
+	"required_if": true, "required_unless": true, "excluded_if": true, "excluded_unless": true,
+}
+
+// fieldParam renames the fields referenced by the param of the tag to the client field names.
+func fieldParam(top, parent reflect.Type, tag, param string) string {
+	switch {
+	case param == "":
+		return param
+
+	case siblingFieldTags[tag]:
+		names := strings.Fields(param)
+		for i, name := range names {
+			names[i], _ = relativePath(parent, splitNamespace(name))
+		}
+		return strings.Join(names, " ")
+
+	case crossStructFieldTags[tag]:
+		path, _ := relativePath(top, splitNamespace(param))
+		return path
+
+	case conditionalFieldTags[tag]:
+		values := strings.Fields(param)
+		for i := 0; i < len(values); i += 2 {
+			values[i], _ = relativePath(parent, splitNamespace(values[i]))
+		}
+		return strings.Join(values, " ")
+	}
+
+	return param
+}
+
+func indirectType(typ reflect.Type) reflect.Type {
+	for typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return typ
+}
@@ -0,0 +1,37 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldErrors(t *testing.T) {
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	input := struct {
+		Name string `json:"name" validate:"required"`
+	}{}
+
+	t.Run("returns the field errors of ValidateStruct", func(t *testing.T) {
+		err := v.ValidateStruct(context.Background(), input)
+		assert.Equal(t, []FieldError{
+			{Field: "name", Tag: "required", Message: "The field 'name' is required"},
+		}, FieldErrors(err))
+	})
+
+	t.Run("returns the field errors of a wrapped error", func(t *testing.T) {
+		err := v.ValidateStruct(context.Background(), input)
+		assert.Len(t, FieldErrors(errors.Join(errors.New("create user"), err)), 1)
+	})
+
+	t.Run("returns nil for other errors", func(t *testing.T) {
+		assert.Nil(t, FieldErrors(nil))
+		assert.Nil(t, FieldErrors(errors.New("some error")))
+		assert.Nil(t, FieldErrors(resterrors.NewBadRequestError("Invalid input data")))
+	})
+}
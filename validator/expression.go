@@ -0,0 +1,660 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ExpressionRootPrefix is the prefix of the identifiers of an expression that start at the top level struct
+// instead of the struct of the field, e.g. $root.country in the tag of a field of a nested struct
+const ExpressionRootPrefix = "$root"
+
+// Expression is a boolean expression over the fields of a struct, used by the required_when and excluded_when tags
+// and by the struct rules, e.g. country == 'BR' && age >= 18. The language has:
+//   - the fields, by their Go name or by the name of their json, form or query tag, e.g. age or Address.State,
+//     and the fields of the top level struct with ExpressionRootPrefix, e.g. $root.country
+//   - strings between single quotes, numbers, true, false and nil
+//   - the comparisons ==, !=, <, <=, > and >=, where only numbers and strings can be ordered
+//   - the operators && (or and), || (or or) and ! (or not), and parentheses
+//
+// The expression is parsed once by CompileExpression, and its fields are resolved and checked once per struct type.
+// A nil pointer in the path of a field is nil, which is only equal to nil.
+type Expression struct {
+	source string
+	root   exprNode
+
+	// bound are the expressions bound to the types of the struct and of the top level struct, by exprTypes
+	bound sync.Map
+}
+
+// ExpressionError is the error of a malformed expression or of a field that can not be used in it
+type ExpressionError struct {
+	Expression string
+	// Position is the byte offset of the error in the expression, starting at 0
+	Position int
+	Message  string
+}
+
+func (e *ExpressionError) Error() string {
+	return fmt.Sprintf("invalid expression %q at position %d: %s", e.Expression, e.Position, e.Message)
+}
+
+// expressionCache caches the expressions compiled by CompileExpression, by source
+var expressionCache sync.Map
+
+// CompileExpression parses the expression, returning an *ExpressionError when it is malformed.
+// The compiled expressions are cached, so the same source is parsed only once.
+func CompileExpression(source string) (*Expression, error) {
+	if cached, ok := expressionCache.Load(source); ok {
+		return cached.(*Expression), nil
+	}
+
+	p := &exprParser{source: source}
+	if err := p.tokenize(); err != nil {
+		return nil, err
+	}
+
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, p.errorf(tok.pos, "unexpected %q", tok.text)
+	}
+
+	expr, _ := expressionCache.LoadOrStore(source, &Expression{source: source, root: root})
+	return expr.(*Expression), nil
+}
+
+// MustCompileExpression is like CompileExpression but panics when the expression is malformed,
+// for the expressions of package variables
+func MustCompileExpression(source string) *Expression {
+	expr, err := CompileExpression(source)
+	if err != nil {
+		panic(err)
+	}
+	return expr
+}
+
+// String returns the source of the expression
+func (e *Expression) String() string {
+	return e.source
+}
+
+// Check resolves the fields of the expression in the struct type of sample, e.g. Customer{} or (*Customer)(nil),
+// returning an *ExpressionError when a field does not exist or can not be compared as the expression does.
+// Call it when registering a struct rule, so the errors are found at startup.
+func (e *Expression) Check(sample any) error {
+	typ := indirectType(reflect.TypeOf(sample))
+	_, err := e.bind(typ, typ)
+	return err
+}
+
+// Eval evaluates the expression with the fields of value, a struct or a pointer to a struct
+func (e *Expression) Eval(value any) (bool, error) {
+	v := reflect.Indirect(reflect.ValueOf(value))
+	if v.Kind() != reflect.Struct {
+		return false, fmt.Errorf("invalid value passed to expression %q: expected a struct, got %T", e.source, value)
+	}
+	return e.eval(v, v)
+}
+
+// eval evaluates the expression with the fields of the struct parent and of the top level struct top
+func (e *Expression) eval(parent, top reflect.Value) (bool, error) {
+	bound, err := e.bind(parent.Type(), top.Type())
+	if err != nil {
+		return false, err
+	}
+
+	result, _ := bound.eval(parent, top).(bool)
+	return result, nil
+}
+
+// exprTypes are the types an expression is bound to
+type exprTypes struct {
+	parent reflect.Type
+	top    reflect.Type
+}
+
+// boundResult is the result of binding an expression, cached also when it fails
+type boundResult struct {
+	operand exprOperand
+	err     error
+}
+
+// bind resolves the fields of the expression in the types and checks the types of the operands, once per types
+func (e *Expression) bind(parent, top reflect.Type) (exprOperand, error) {
+	key := exprTypes{parent: parent, top: top}
+	if cached, ok := e.bound.Load(key); ok {
+		result := cached.(boundResult)
+		return result.operand, result.err
+	}
+
+	b := &exprBinder{source: e.source, parent: parent, top: top}
+	operand, err := b.bind(e.root)
+	if err == nil && operand.kind != exprBool && operand.kind != exprAny {
+		err = b.errorf(0, "the expression should be a boolean, got a %s", operand.kind)
+	}
+
+	e.bound.Store(key, boundResult{operand: operand, err: err})
+	return operand, err
+}
+
+// tokens
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenOperator
+	tokenLParen
+	tokenRParen
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// exprKeywords are the identifiers with a meaning in the language, as the operators they are aliases of
+var exprKeywords = map[string]string{"and": "&&", "or": "||", "not": "!"}
+
+// exprOperators are the operators of the language, the longest first
+var exprOperators = []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "!"}
+
+// parser
+
+type exprParser struct {
+	source string
+	tokens []token
+	next   int
+}
+
+func (p *exprParser) errorf(pos int, format string, args ...any) error {
+	return &ExpressionError{Expression: p.source, Position: pos, Message: fmt.Sprintf(format, args...)}
+}
+
+func (p *exprParser) tokenize() error {
+	src := p.source
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+
+		case c == '(' || c == ')':
+			kind := tokenLParen
+			if c == ')' {
+				kind = tokenRParen
+			}
+			p.tokens = append(p.tokens, token{kind: kind, text: string(c), pos: i})
+			i++
+
+		case c == '\'':
+			var sb strings.Builder
+			j := i + 1
+			for ; j < len(src) && src[j] != '\''; j++ {
+				if src[j] == '\\' && j+1 < len(src) {
+					j++
+				}
+				sb.WriteByte(src[j])
+			}
+			if j >= len(src) {
+				return p.errorf(i, "unterminated string")
+			}
+			p.tokens = append(p.tokens, token{kind: tokenString, text: sb.String(), pos: i})
+			i = j + 1
+
+		case isDigit(c) || (c == '-' && i+1 < len(src) && isDigit(src[i+1])):
+			j := i + 1
+			for j < len(src) && (isDigit(src[j]) || src[j] == '.') {
+				j++
+			}
+			if _, err := strconv.ParseFloat(src[i:j], 64); err != nil {
+				return p.errorf(i, "invalid number %q", src[i:j])
+			}
+			p.tokens = append(p.tokens, token{kind: tokenNumber, text: src[i:j], pos: i})
+			i = j
+
+		case isIdentStart(c):
+			j := i + 1
+			for j < len(src) && (isIdentStart(src[j]) || isDigit(src[j]) || src[j] == '.') {
+				j++
+			}
+			text := src[i:j]
+			if operator, ok := exprKeywords[text]; ok {
+				p.tokens = append(p.tokens, token{kind: tokenOperator, text: operator, pos: i})
+			} else {
+				p.tokens = append(p.tokens, token{kind: tokenIdent, text: text, pos: i})
+			}
+			i = j
+
+		default:
+			operator := ""
+			for _, op := range exprOperators {
+				if strings.HasPrefix(src[i:], op) {
+					operator = op
+					break
+				}
+			}
+			if operator == "" {
+				return p.errorf(i, "unexpected character %q", c)
+			}
+			p.tokens = append(p.tokens, token{kind: tokenOperator, text: operator, pos: i})
+			i += len(operator)
+		}
+	}
+
+	p.tokens = append(p.tokens, token{kind: tokenEOF, text: "end of the expression", pos: len(src)})
+	return nil
+}
+
+func (p *exprParser) peek() token {
+	return p.tokens[p.next]
+}
+
+func (p *exprParser) take() token {
+	tok := p.tokens[p.next]
+	if tok.kind != tokenEOF {
+		p.next++
+	}
+	return tok
+}
+
+// parseOr parses the lowest precedence: or := and ("||" and)*
+func (p *exprParser) parseOr() (exprNode, error) {
+	left, err := p.parseAnd()
+	for err == nil && p.peek().text == "||" && p.peek().kind == tokenOperator {
+		tok := p.take()
+		var right exprNode
+		right, err = p.parseAnd()
+		left = &binaryNode{op: tok.text, pos: tok.pos, left: left, right: right}
+	}
+	return left, err
+}
+
+// parseAnd parses: and := not ("&&" not)*
+func (p *exprParser) parseAnd() (exprNode, error) {
+	left, err := p.parseNot()
+	for err == nil && p.peek().text == "&&" && p.peek().kind == tokenOperator {
+		tok := p.take()
+		var right exprNode
+		right, err = p.parseNot()
+		left = &binaryNode{op: tok.text, pos: tok.pos, left: left, right: right}
+	}
+	return left, err
+}
+
+// parseNot parses: not := "!" not | comparison
+func (p *exprParser) parseNot() (exprNode, error) {
+	if tok := p.peek(); tok.kind == tokenOperator && tok.text == "!" {
+		p.take()
+		operand, err := p.parseNot()
+		return &notNode{pos: tok.pos, operand: operand}, err
+	}
+	return p.parseComparison()
+}
+
+// parseComparison parses: comparison := primary (op primary)?
+func (p *exprParser) parseComparison() (exprNode, error) {
+	left, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	tok := p.peek()
+	switch tok.text {
+	case "==", "!=", "<", "<=", ">", ">=":
+		if tok.kind != tokenOperator {
+			return left, nil
+		}
+		p.take()
+		right, err := p.parsePrimary()
+		return &binaryNode{op: tok.text, pos: tok.pos, left: left, right: right}, err
+	}
+	return left, nil
+}
+
+// parsePrimary parses a value: a literal, a field or an expression between parentheses
+func (p *exprParser) parsePrimary() (exprNode, error) {
+	tok := p.take()
+	switch tok.kind {
+	case tokenString:
+		return &literalNode{value: tok.text}, nil
+
+	case tokenNumber:
+		number, _ := strconv.ParseFloat(tok.text, 64)
+		return &literalNode{value: number}, nil
+
+	case tokenIdent:
+		switch tok.text {
+		case "true", "false":
+			return &literalNode{value: tok.text == "true"}, nil
+		case "nil", "null":
+			return &literalNode{}, nil
+		}
+		return &fieldNode{path: tok.text, pos: tok.pos}, nil
+
+	case tokenLParen:
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.take(); closing.kind != tokenRParen {
+			return nil, p.errorf(closing.pos, "expected ) but got %q", closing.text)
+		}
+		return node, nil
+	}
+
+	return nil, p.errorf(tok.pos, "expected a field or a value but got %q", tok.text)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// syntax tree
+
+type exprNode any
+
+type literalNode struct {
+	// value is a string, a float64, a bool or nil
+	value any
+}
+
+type fieldNode struct {
+	path string
+	pos  int
+}
+
+type notNode struct {
+	pos     int
+	operand exprNode
+}
+
+type binaryNode struct {
+	op    string
+	pos   int
+	left  exprNode
+	right exprNode
+}
+
+// binding
+
+// exprKind is the type of an operand, checked when the expression is bound to a struct type
+type exprKind string
+
+const (
+	exprString exprKind = "string"
+	exprNumber exprKind = "number"
+	exprBool   exprKind = "boolean"
+	exprNil    exprKind = "nil"
+	// exprAny is the kind of the interface fields, checked only when evaluated
+	exprAny exprKind = "any"
+)
+
+// exprOperand is a bound node, which evaluates to a string, a float64, a bool or nil
+type exprOperand struct {
+	kind exprKind
+	eval func(parent, top reflect.Value) any
+}
+
+type exprBinder struct {
+	source string
+	parent reflect.Type
+	top    reflect.Type
+}
+
+func (b *exprBinder) errorf(pos int, format string, args ...any) error {
+	return &ExpressionError{Expression: b.source, Position: pos, Message: fmt.Sprintf(format, args...)}
+}
+
+func (b *exprBinder) bind(node exprNode) (exprOperand, error) {
+	switch node := node.(type) {
+	case *literalNode:
+		value := node.value
+		return exprOperand{kind: literalKind(value), eval: func(_, _ reflect.Value) any { return value }}, nil
+
+	case *fieldNode:
+		return b.bindField(node)
+
+	case *notNode:
+		operand, err := b.bind(node.operand)
+		if err != nil {
+			return exprOperand{}, err
+		}
+		if operand.kind != exprBool && operand.kind != exprAny {
+			return exprOperand{}, b.errorf(node.pos, "! should be applied to a boolean, got a %s", operand.kind)
+		}
+		return exprOperand{kind: exprBool, eval: func(parent, top reflect.Value) any {
+			value, _ := operand.eval(parent, top).(bool)
+			return !value
+		}}, nil
+
+	case *binaryNode:
+		left, err := b.bind(node.left)
+		if err != nil {
+			return exprOperand{}, err
+		}
+		right, err := b.bind(node.right)
+		if err != nil {
+			return exprOperand{}, err
+		}
+		return b.bindBinary(node, left, right)
+	}
+
+	return exprOperand{}, b.errorf(0, "unknown node %T", node)
+}
+
+func (b *exprBinder) bindBinary(node *binaryNode, left, right exprOperand) (exprOperand, error) {
+	switch node.op {
+	case "&&", "||":
+		for _, operand := range []exprOperand{left, right} {
+			if operand.kind != exprBool && operand.kind != exprAny {
+				return exprOperand{}, b.errorf(node.pos, "%s should join booleans, got a %s", node.op, operand.kind)
+			}
+		}
+
+		and := node.op == "&&"
+		return exprOperand{kind: exprBool, eval: func(parent, top reflect.Value) any {
+			// short-circuit, as in Go
+			value, _ := left.eval(parent, top).(bool)
+			if value != and {
+				return value
+			}
+			value, _ = right.eval(parent, top).(bool)
+			return value
+		}}, nil
+
+	case "==", "!=":
+		if !comparableKinds(left.kind, right.kind) {
+			return exprOperand{}, b.errorf(node.pos, "can not compare a %s with a %s", left.kind, right.kind)
+		}
+
+		equal := node.op == "=="
+		return exprOperand{kind: exprBool, eval: func(parent, top reflect.Value) any {
+			return (left.eval(parent, top) == right.eval(parent, top)) == equal
+		}}, nil
+	}
+
+	// the ordering operators
+	for _, operand := range []exprOperand{left, right} {
+		if operand.kind != exprNumber && operand.kind != exprString && operand.kind != exprAny {
+			return exprOperand{}, b.errorf(node.pos, "%s should compare numbers or strings, got a %s", node.op, operand.kind)
+		}
+	}
+	if !comparableKinds(left.kind, right.kind) {
+		return exprOperand{}, b.errorf(node.pos, "can not compare a %s with a %s", left.kind, right.kind)
+	}
+
+	op := node.op
+	return exprOperand{kind: exprBool, eval: func(parent, top reflect.Value) any {
+		cmp, ok := compareValues(left.eval(parent, top), right.eval(parent, top))
+		if !ok {
+			return false
+		}
+
+		switch op {
+		case "<":
+			return cmp < 0
+		case "<=":
+			return cmp <= 0
+		case ">":
+			return cmp > 0
+		default:
+			return cmp >= 0
+		}
+	}}, nil
+}
+
+// bindField resolves the path of the field in the struct types, by the Go names or the names of the json, form and query tags
+func (b *exprBinder) bindField(node *fieldNode) (exprOperand, error) {
+	typ := b.parent
+	path := node.path
+	fromTop := false
+	if rest, found := strings.CutPrefix(path, ExpressionRootPrefix+"."); found {
+		typ, path, fromTop = b.top, rest, true
+	} else if strings.HasPrefix(path, "$") {
+		return exprOperand{}, b.errorf(node.pos, "unknown identifier %q, only %s. is allowed", path, ExpressionRootPrefix)
+	}
+
+	var indexes [][]int
+	for _, name := range strings.Split(path, ".") {
+		typ = indirectType(typ)
+		if typ == nil || typ.Kind() != reflect.Struct {
+			return exprOperand{}, b.errorf(node.pos, "the field %q is not in a struct", node.path)
+		}
+
+		fld, ok := exprStructField(typ, name)
+		if !ok {
+			return exprOperand{}, b.errorf(node.pos, "unknown field %q in %s", name, typ)
+		}
+		indexes = append(indexes, fld.Index)
+		typ = fld.Type
+	}
+
+	kind, ok := fieldKind(typ)
+	if !ok {
+		return exprOperand{}, b.errorf(node.pos, "the field %q of type %s can not be used in an expression", node.path, typ)
+	}
+
+	return exprOperand{kind: kind, eval: func(parent, top reflect.Value) any {
+		value := parent
+		if fromTop {
+			value = top
+		}
+
+		for _, index := range indexes {
+			value = reflect.Indirect(value)
+			if !value.IsValid() {
+				return nil
+			}
+			value, _ = value.FieldByIndexErr(index)
+		}
+		return exprValue(value)
+	}}, nil
+}
+
+// exprStructField returns the field of the struct by its Go name or by the name of its json, form or query tag
+func exprStructField(typ reflect.Type, name string) (reflect.StructField, bool) {
+	if fld, ok := typ.FieldByName(name); ok && fld.IsExported() {
+		return fld, true
+	}
+
+	for _, fld := range reflect.VisibleFields(typ) {
+		if fld.IsExported() && fieldName(fld) == name {
+			return fld, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// fieldKind returns the kind of the operand of a field type, which can be a pointer to a string, a number or a boolean
+func fieldKind(typ reflect.Type) (exprKind, bool) {
+	switch indirectType(typ).Kind() {
+	case reflect.String:
+		return exprString, true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return exprNumber, true
+	case reflect.Bool:
+		return exprBool, true
+	case reflect.Interface:
+		return exprAny, true
+	}
+	return "", false
+}
+
+// exprValue converts the value of a field to a string, a float64, a bool or nil
+func exprValue(value reflect.Value) any {
+	for value.IsValid() && (value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface) {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		return value.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(value.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(value.Uint())
+	case reflect.Float32, reflect.Float64:
+		return value.Float()
+	case reflect.Bool:
+		return value.Bool()
+	}
+	return nil
+}
+
+func literalKind(value any) exprKind {
+	switch value.(type) {
+	case string:
+		return exprString
+	case float64:
+		return exprNumber
+	case bool:
+		return exprBool
+	}
+	return exprNil
+}
+
+// comparableKinds reports whether the operands can be compared, nil and the interface fields can be compared with anything
+func comparableKinds(left, right exprKind) bool {
+	return left == right || left == exprNil || right == exprNil || left == exprAny || right == exprAny
+}
+
+// compareValues compares two numbers or two strings, ok is false for the other values, e.g. nil
+func compareValues(left, right any) (cmp int, ok bool) {
+	switch left := left.(type) {
+	case float64:
+		if right, ok := right.(float64); ok {
+			switch {
+			case left < right:
+				return -1, true
+			case left > right:
+				return 1, true
+			}
+			return 0, true
+		}
+	case string:
+		if right, ok := right.(string); ok {
+			return strings.Compare(left, right), true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,157 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type expressionAddress struct {
+	State   string  `json:"state"`
+	Country *string `json:"country"`
+}
+
+type expressionCustomer struct {
+	Country      string             `json:"country"`
+	Age          int                `json:"age"`
+	Income       *float64           `json:"income"`
+	AcceptsTerms bool               `json:"accepts_terms"`
+	Address      *expressionAddress `json:"address"`
+	Extra        any                `json:"extra"`
+	Tags         []string           `json:"tags"`
+}
+
+func TestCompileExpression_errors(t *testing.T) {
+	tests := []struct {
+		expression   string
+		wantPosition int
+		wantMessage  string
+	}{
+		{expression: "country == ", wantPosition: 11, wantMessage: `expected a field or a value but got "end of the expression"`},
+		{expression: "country == 'BR", wantPosition: 11, wantMessage: "unterminated string"},
+		{expression: "(age > 18", wantPosition: 9, wantMessage: `expected ) but got "end of the expression"`},
+		{expression: "age > 18)", wantPosition: 8, wantMessage: `unexpected ")"`},
+		{expression: "age = 18", wantPosition: 4, wantMessage: "unexpected character '='"},
+		{expression: "age > 1.2.3", wantPosition: 6, wantMessage: `invalid number "1.2.3"`},
+		{expression: "country == 'BR' age", wantPosition: 16, wantMessage: `unexpected "age"`},
+		{expression: "age + 1", wantPosition: 4, wantMessage: "unexpected character '+'"},
+		{expression: "age > -1 == true", wantPosition: 9, wantMessage: `unexpected "=="`},
+		{expression: "", wantPosition: 0, wantMessage: `expected a field or a value but got "end of the expression"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			expr, err := CompileExpression(tt.expression)
+			assert.Nil(t, expr)
+
+			exprErr, ok := err.(*ExpressionError)
+			if assert.True(t, ok, "error %v", err) {
+				assert.Equal(t, tt.expression, exprErr.Expression)
+				assert.Equal(t, tt.wantPosition, exprErr.Position)
+				assert.Equal(t, tt.wantMessage, exprErr.Message)
+			}
+		})
+	}
+
+	assert.Panics(t, func() { MustCompileExpression("age >") })
+}
+
+func TestCompileExpression_cache(t *testing.T) {
+	first, err := CompileExpression("age >= 18")
+	assert.NoError(t, err)
+
+	second, err := CompileExpression("age >= 18")
+	assert.NoError(t, err)
+	assert.Same(t, first, second)
+	assert.Equal(t, "age >= 18", second.String())
+}
+
+func TestExpression_Check(t *testing.T) {
+	tests := []struct {
+		expression  string
+		wantMessage string
+	}{
+		{expression: "country == 'BR' && age >= 18"},
+		{expression: "Country == 'BR' and not AcceptsTerms"},
+		{expression: "address.country == nil or address.State != 'SP'"},
+		{expression: "extra == 10 || extra == 'ten'"},
+		{expression: "contry == 'BR'", wantMessage: `unknown field "contry" in validator.expressionCustomer`},
+		{expression: "age == 'eighteen'", wantMessage: "can not compare a number with a string"},
+		{expression: "accepts_terms > false", wantMessage: "> should compare numbers or strings, got a boolean"},
+		{expression: "age && accepts_terms", wantMessage: "&& should join booleans, got a number"},
+		{expression: "!country", wantMessage: "! should be applied to a boolean, got a string"},
+		{expression: "tags == nil", wantMessage: `the field "tags" of type []string can not be used in an expression`},
+		{expression: "country", wantMessage: "the expression should be a boolean, got a string"},
+		{expression: "country.name == 'x'", wantMessage: `the field "country.name" is not in a struct`},
+		{expression: "$parent.country == 'BR'", wantMessage: `unknown identifier "$parent.country", only $root. is allowed`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			expr, err := CompileExpression(tt.expression)
+			assert.NoError(t, err)
+
+			err = expr.Check(expressionCustomer{})
+			if tt.wantMessage == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			exprErr, ok := err.(*ExpressionError)
+			if assert.True(t, ok, "error %v", err) {
+				assert.Equal(t, tt.wantMessage, exprErr.Message)
+			}
+		})
+	}
+}
+
+func TestExpression_Eval(t *testing.T) {
+	brazil := "BR"
+	income := 1500.5
+
+	customer := expressionCustomer{
+		Country:      "BR",
+		Age:          30,
+		Income:       &income,
+		AcceptsTerms: true,
+		Address:      &expressionAddress{State: "SP", Country: &brazil},
+		Extra:        "ten",
+	}
+
+	tests := []struct {
+		expression string
+		customer   expressionCustomer
+		want       bool
+	}{
+		{expression: "country == 'BR' && age >= 18", customer: customer, want: true},
+		{expression: "country == 'BR' && age >= 31", customer: customer, want: false},
+		{expression: "country != 'BR' || age > 29.5", customer: customer, want: true},
+		{expression: "!(country == 'BR') or not accepts_terms", customer: customer, want: false},
+		{expression: "income > 1500 and income <= 1500.50", customer: customer, want: true},
+		{expression: "income == nil", customer: expressionCustomer{}, want: true},
+		{expression: "income < 1000", customer: expressionCustomer{}, want: false},
+		{expression: "address.country == 'BR' && address.state >= 'RJ'", customer: customer, want: true},
+		{expression: "address.state == nil", customer: expressionCustomer{}, want: true},
+		{expression: "address.state != 'SP'", customer: expressionCustomer{}, want: true},
+		{expression: "extra == 'ten'", customer: customer, want: true},
+		{expression: "extra > 5", customer: customer, want: false},
+		{expression: "country == 'it\\'s'", customer: expressionCustomer{Country: "it's"}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			expr, err := CompileExpression(tt.expression)
+			assert.NoError(t, err)
+
+			got, err := expr.Eval(&tt.customer)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := MustCompileExpression("age > 1").Eval(10)
+	assert.Error(t, err)
+
+	_, err = MustCompileExpression("agee > 1").Eval(customer)
+	assert.Error(t, err)
+}
@@ -0,0 +1,166 @@
+package validator
+
+import (
+	"image"
+	_ "image/gif" // registers the decoders of the images checked by min_dimensions and max_dimensions
+	_ "image/jpeg"
+	_ "image/png"
+	"mime/multipart"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/go-playground/validator/v10"
+)
+
+// sizeUnits are the units of the max_size param, multiples of 1024
+var sizeUnits = map[string]int64{
+	"":   1,
+	"B":  1,
+	"KB": 1 << 10,
+	"MB": 1 << 20,
+	"GB": 1 << 30,
+}
+
+// fileValidations are the tags of the uploaded files, for *multipart.FileHeader fields
+var fileValidations = map[string]validator.Func{
+	// max_size is the max size of the file, in bytes or with a unit, e.g. max_size=5MB
+	"max_size": func(fl validator.FieldLevel) bool {
+		file, ok := fieldFileHeader(fl.Field())
+		maxSize, valid := parseSize(fl.Param())
+		return ok && valid && file.Size <= maxSize
+	},
+	// mime are the allowed types of the content of the file, e.g. mime=image/png image/jpeg, or image/* for any image.
+	// The type is detected from the content, the Content-Type sent by the client is not trusted.
+	// Unlike pix_key=cpf|email, the types can not be separated by | in the tags: go-playground reads each type after
+	// the pipe as a tag, and the types are not a closed list that could be registered as tags, so mime=image/png|image/jpeg
+	// panics with an undefined validation function. The types are separated by spaces or by the escaped pipe 0x7C.
+	"mime": func(fl validator.FieldLevel) bool {
+		file, ok := fieldFileHeader(fl.Field())
+		if !ok {
+			return false
+		}
+
+		detected, err := detectFileType(file)
+		if err != nil {
+			return false
+		}
+
+		for _, allowed := range paramList(fl.Param()) {
+			if detected.Is(allowed) {
+				return true
+			}
+			if prefix, found := strings.CutSuffix(allowed, "/*"); found && strings.HasPrefix(detected.String(), prefix+"/") {
+				return true
+			}
+		}
+		return false
+	},
+	// ext are the allowed extensions of the file name, case insensitive, e.g. ext=pdf or ext=jpg jpeg png
+	"ext": func(fl validator.FieldLevel) bool {
+		file, ok := fieldFileHeader(fl.Field())
+		if !ok {
+			return false
+		}
+
+		ext := strings.TrimPrefix(filepath.Ext(file.Filename), ".")
+		for _, allowed := range paramList(fl.Param()) {
+			if strings.EqualFold(ext, strings.TrimPrefix(allowed, ".")) {
+				return true
+			}
+		}
+		return false
+	},
+	// min_dimensions is the min width and height of an image (png, jpeg or gif), e.g. min_dimensions=200x200
+	"min_dimensions": func(fl validator.FieldLevel) bool {
+		width, height, ok := imageDimensions(fl)
+		minWidth, minHeight, valid := parseDimensions(fl.Param())
+		return ok && valid && width >= minWidth && height >= minHeight
+	},
+	// max_dimensions is the max width and height of an image (png, jpeg or gif), e.g. max_dimensions=1920x1080
+	"max_dimensions": func(fl validator.FieldLevel) bool {
+		width, height, ok := imageDimensions(fl)
+		maxWidth, maxHeight, valid := parseDimensions(fl.Param())
+		return ok && valid && width <= maxWidth && height <= maxHeight
+	},
+}
+
+// fieldFileHeader returns the file of a multipart.FileHeader field, the pointer is dereferenced by go-playground
+func fieldFileHeader(field reflect.Value) (*multipart.FileHeader, bool) {
+	if field.Type() != reflect.TypeFor[multipart.FileHeader]() {
+		return nil, false
+	}
+
+	if field.CanAddr() {
+		return field.Addr().Interface().(*multipart.FileHeader), true
+	}
+
+	file := field.Interface().(multipart.FileHeader)
+	return &file, true
+}
+
+// detectFileType returns the type of the content of the file, reading only its first bytes
+func detectFileType(file *multipart.FileHeader) (*mimetype.MIME, error) {
+	content, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer content.Close()
+
+	return mimetype.DetectReader(content)
+}
+
+// imageDimensions returns the width and height of the image of the file field, reading only its header
+func imageDimensions(fl validator.FieldLevel) (width, height int, ok bool) {
+	file, ok := fieldFileHeader(fl.Field())
+	if !ok {
+		return 0, 0, false
+	}
+
+	content, err := file.Open()
+	if err != nil {
+		return 0, 0, false
+	}
+	defer content.Close()
+
+	config, _, err := image.DecodeConfig(content)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return config.Width, config.Height, true
+}
+
+// parseSize parses a size in bytes with an optional unit, e.g. 512KB or 5MB
+func parseSize(param string) (int64, bool) {
+	param = strings.ToUpper(strings.TrimSpace(param))
+	digits := strings.TrimRightFunc(param, func(r rune) bool {
+		return r < '0' || r > '9'
+	})
+
+	unit, ok := sizeUnits[strings.TrimSpace(param[len(digits):])]
+	if !ok {
+		return 0, false
+	}
+
+	size, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return size * unit, true
+}
+
+// parseDimensions parses the width and height of a param like 1920x1080
+func parseDimensions(param string) (width, height int, ok bool) {
+	w, h, found := strings.Cut(strings.ToLower(param), "x")
+	if !found {
+		return 0, 0, false
+	}
+
+	width, errWidth := strconv.Atoi(w)
+	height, errHeight := strconv.Atoi(h)
+	return width, height, errWidth == nil && errHeight == nil
+}
@@ -0,0 +1,152 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"mime/multipart"
+	"net/textproto"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newFileHeader returns the file of a multipart form with the content, sent with the content type
+func newFileHeader(t *testing.T, filename, contentType string, content []byte) *multipart.FileHeader {
+	t.Helper()
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", `form-data; name="file"; filename="`+filename+`"`)
+	header.Set("Content-Type", contentType)
+	part, err := writer.CreatePart(header)
+	assert.NoError(t, err)
+	_, err = part.Write(content)
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Close())
+
+	form, err := multipart.NewReader(body, writer.Boundary()).ReadForm(1 << 20)
+	assert.NoError(t, err)
+	return form.File["file"][0]
+}
+
+func pngImage(t *testing.T, width, height int) []byte {
+	t.Helper()
+
+	content := &bytes.Buffer{}
+	assert.NoError(t, png.Encode(content, image.NewRGBA(image.Rect(0, 0, width, height))))
+	return content.Bytes()
+}
+
+func Test_validatorImpl_ValidateStruct_Files(t *testing.T) {
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	avatar := newFileHeader(t, "avatar.PNG", "image/png", pngImage(t, 300, 200))
+	pdf := []byte("%PDF-1.4\n1 0 obj\n<<>>\nendobj\ntrailer\n<<>>\n%%EOF")
+
+	tests := []struct {
+		name  string
+		file  *multipart.FileHeader
+		tag   string
+		valid bool
+	}{
+		{name: "max_size", file: avatar, tag: "max_size=10KB", valid: true},
+		{name: "max_size exceeded", file: avatar, tag: "max_size=100", valid: false},
+		{name: "max_size with lowercase unit", file: avatar, tag: "max_size=1mb", valid: true},
+		{name: "max_size invalid param", file: avatar, tag: "max_size=1TB", valid: false},
+		{name: "mime", file: avatar, tag: "mime=image/png image/jpeg", valid: true},
+		{name: "mime with escaped pipe", file: avatar, tag: "mime=image/jpeg0x7Cimage/png", valid: true},
+		{name: "mime wildcard", file: avatar, tag: "mime=image/*", valid: true},
+		{name: "mime not allowed", file: avatar, tag: "mime=application/pdf", valid: false},
+		{name: "mime from the content, not the header", file: newFileHeader(t, "avatar.png", "image/png", pdf), tag: "mime=image/png", valid: false},
+		{name: "mime of a pdf", file: newFileHeader(t, "contract.pdf", "application/octet-stream", pdf), tag: "mime=application/pdf", valid: true},
+		{name: "ext case insensitive", file: avatar, tag: "ext=png", valid: true},
+		{name: "ext list", file: avatar, tag: "ext=jpg .png", valid: true},
+		{name: "ext not allowed", file: avatar, tag: "ext=pdf", valid: false},
+		{name: "min_dimensions", file: avatar, tag: "min_dimensions=300x200", valid: true},
+		{name: "min_dimensions too small", file: avatar, tag: "min_dimensions=300x201", valid: false},
+		{name: "max_dimensions", file: avatar, tag: "max_dimensions=1920x1080", valid: true},
+		{name: "max_dimensions too large", file: avatar, tag: "max_dimensions=299x1080", valid: false},
+		{name: "dimensions of a file that is not an image", file: newFileHeader(t, "contract.pdf", "application/pdf", pdf), tag: "max_dimensions=1920x1080", valid: false},
+		{name: "dimensions invalid param", file: avatar, tag: "max_dimensions=1920", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// go-playground skips the tags of struct values given to Var, so the file is validated as a struct field
+			typ := reflect.StructOf([]reflect.StructField{
+				{Name: "File", Type: reflect.TypeFor[*multipart.FileHeader](), Tag: reflect.StructTag(`validate:"` + tt.tag + `"`)},
+			})
+			value := reflect.New(typ).Elem()
+			value.Field(0).Set(reflect.ValueOf(tt.file))
+
+			err := v.ValidateStruct(context.Background(), value.Interface())
+			assert.Equal(t, tt.valid, err == nil)
+		})
+	}
+
+	t.Run("struct fields", func(t *testing.T) {
+		type upload struct {
+			Avatar      *multipart.FileHeader   `form:"avatar" validate:"required,max_size=5MB,mime=image/png image/jpeg,max_dimensions=256x256"`
+			Attachments []*multipart.FileHeader `form:"attachments" validate:"max=2,dive,ext=pdf"`
+		}
+
+		err := v.ValidateStruct(ContextWithLocale(context.Background(), LocalePTBR), upload{
+			Avatar:      avatar,
+			Attachments: []*multipart.FileHeader{newFileHeader(t, "contract.pdf", "application/pdf", pdf), avatar},
+		})
+		assert.Equal(t, []FieldError{
+			{Field: "avatar", Tag: "max_dimensions", Param: "256x256", Message: "A imagem 'avatar' deve ter no máximo 256x256 pixels"},
+			{Field: "attachments[1]", Tag: "ext", Param: "pdf", Message: "O arquivo 'attachments[1]' deve ter a extensão pdf"},
+		}, FieldErrors(err))
+
+		err = v.ValidateStruct(context.Background(), upload{})
+		assert.Equal(t, []FieldError{
+			{Field: "avatar", Tag: "required", Message: "The field 'avatar' is required"},
+		}, FieldErrors(err))
+	})
+}
+
+func Test_validatorImpl_ValidateStruct_FilesMimePipe(t *testing.T) {
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	// the types separated by | are read by go-playground as tags, see the mime validation
+	type upload struct {
+		Avatar *multipart.FileHeader `form:"avatar" validate:"mime=image/png|image/jpeg"`
+	}
+	assert.PanicsWithValue(t, "Undefined validation function 'image/jpeg' on field 'Avatar'", func() {
+		_ = v.ValidateStruct(context.Background(), upload{})
+	})
+}
+
+func Test_parseSize(t *testing.T) {
+	tests := []struct {
+		param string
+		want  int64
+		ok    bool
+	}{
+		{param: "512", want: 512, ok: true},
+		{param: "512B", want: 512, ok: true},
+		{param: "10KB", want: 10 << 10, ok: true},
+		{param: "5MB", want: 5 << 20, ok: true},
+		{param: "5 mb", want: 5 << 20, ok: true},
+		{param: "1GB", want: 1 << 30, ok: true},
+		{param: "MB", ok: false},
+		{param: "5PB", ok: false},
+		{param: "", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.param, func(t *testing.T) {
+			got, ok := parseSize(tt.param)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+// Package ginvalidator plugs the validator package into gin, so the custom tags, the normalize tags
+// and the resterrors error format are used by the gin binding (c.ShouldBind, c.ShouldBindJSON, ...).
+//
+//	binding.Validator = ginvalidator.New(v)
+//	router.Use(ginvalidator.ErrorHandler())
+package ginvalidator
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/go_utils/validator"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+type structValidator struct {
+	validator validator.Validator
+
+	// contexts has the request context of the objects being bound by ShouldBind and ShouldBindWith, by object
+	contexts sync.Map
+}
+
+// New returns a gin binding.StructValidator that validates with the given validator.
+// The struct fields are validated with the validate tag (not the binding tag of the gin default validator),
+// and the normalize tags are applied before the validation when gin binds into a pointer.
+//
+// The gin interface does not receive the request context, so c.ShouldBind* validate with context.Background():
+// use ShouldBind, ShouldBindJSON or ShouldBindWith of this package to validate with the request context.
+func New(v validator.Validator) binding.StructValidator {
+	return &structValidator{validator: v}
+}
+
+// ValidateStruct follows the gin contract: structs and pointers to structs are validated, slices and arrays
+// of structs are validated with ValidateSlice, whose errors have the index of the invalid items (see validator.RowErrors),
+// and other types are skipped.
+func (s *structValidator) ValidateStruct(obj any) error {
+	if obj == nil {
+		return nil
+	}
+
+	value := reflect.ValueOf(obj)
+
+	ctx := context.Background()
+	if value.Kind() == reflect.Ptr {
+		if requestCtx, ok := s.contexts.Load(obj); ok {
+			ctx = requestCtx.(context.Context)
+		}
+	}
+
+	switch reflect.Indirect(value).Kind() {
+	case reflect.Struct:
+		return s.validate(ctx, value)
+
+	case reflect.Slice, reflect.Array:
+		elems := reflect.Indirect(value)
+		if indirectType(elems.Type().Elem()).Kind() != reflect.Struct {
+			return nil
+		}
+
+		for i := 0; i < elems.Len(); i++ {
+			elem := elems.Index(i)
+			if elem.Kind() != reflect.Ptr && elem.CanAddr() {
+				elem = elem.Addr()
+			}
+
+			err := s.normalize(ctx, elem)
+			if err != nil {
+				return err
+			}
+		}
+
+		return s.validator.ValidateSlice(ctx, elems.Interface())
+	}
+
+	return nil
+}
+
+// validate normalizes the struct when it is addressable and validates it
+func (s *structValidator) validate(ctx context.Context, value reflect.Value) error {
+	if value.Kind() == reflect.Ptr && value.IsNil() {
+		return nil
+	}
+
+	err := s.normalize(ctx, value)
+	if err != nil {
+		return err
+	}
+
+	return s.validator.ValidateStruct(ctx, value.Interface())
+}
+
+// normalize applies the normalize tags when the value is a pointer to a struct
+func (s *structValidator) normalize(ctx context.Context, value reflect.Value) error {
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		return nil
+	}
+	return s.validator.Normalize(ctx, value.Interface())
+}
+
+func indirectType(typ reflect.Type) reflect.Type {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return typ
+}
+
+// ShouldBind binds the request into obj like c.ShouldBind, but validates it with the request context,
+// so the locale of validator.ContextWithLocale and the cancellation of the request reach the validator.
+// It needs binding.Validator set with New, otherwise it is the same as c.ShouldBind.
+func ShouldBind(c *gin.Context, obj any) error {
+	return ShouldBindWith(c, obj, binding.Default(c.Request.Method, c.ContentType()))
+}
+
+// ShouldBindJSON binds the JSON body into obj like c.ShouldBindJSON, validating it with the request context
+func ShouldBindJSON(c *gin.Context, obj any) error {
+	return ShouldBindWith(c, obj, binding.JSON)
+}
+
+// ShouldBindWith binds the request into obj with the binding like c.ShouldBindWith, validating it with the request context
+func ShouldBindWith(c *gin.Context, obj any, b binding.Binding) error {
+	// gin gives only obj to the validator, so the context is found by obj, which is a pointer unique to the request
+	s, ok := binding.Validator.(*structValidator)
+	if ok && reflect.ValueOf(obj).Kind() == reflect.Ptr {
+		s.contexts.Store(obj, c.Request.Context())
+		defer s.contexts.Delete(obj)
+	}
+
+	return c.ShouldBindWith(obj, b)
+}
+
+// Engine returns the validator.Validator used to validate the structs
+func (s *structValidator) Engine() any {
+	return s.validator
+}
+
+// ErrorHandler returns a gin middleware that renders the last error added to the context, with c.Error,
+// when it is a resterrors.RestErr and nothing was written to the response yet.
+// The response has the status code of the error and its JSON as body. Other errors are left to the next handlers.
+//
+//	input := CreateUserInput{}
+//	if err := c.ShouldBindJSON(&input); err != nil {
+//		_ = c.Error(err)
+//		return
+//	}
+func ErrorHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+
+		var restErr resterrors.RestErr
+		if !errors.As(c.Errors.Last().Err, &restErr) {
+			return
+		}
+
+		c.AbortWithStatusJSON(restErr.StatusCode(), restErr)
+	}
+}
@@ -0,0 +1,183 @@
+package ginvalidator
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/diegoclair/go_utils/validator"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/stretchr/testify/assert"
+)
+
+type userInput struct {
+	Name string `json:"name" normalize:"trim" validate:"required_trim"`
+	CPF  string `json:"cpf" normalize:"unmask_cpf" validate:"required,cpf"`
+}
+
+func TestStructValidator_ValidateStruct(t *testing.T) {
+	v, err := validator.NewValidator()
+	assert.NoError(t, err)
+	sv := New(v)
+
+	t.Run("normalizes and validates a pointer to struct", func(t *testing.T) {
+		input := &userInput{Name: " John ", CPF: "529.982.247-25"}
+		assert.NoError(t, sv.ValidateStruct(input))
+		assert.Equal(t, &userInput{Name: "John", CPF: "52998224725"}, input)
+	})
+
+	t.Run("returns the field errors", func(t *testing.T) {
+		err := sv.ValidateStruct(userInput{Name: " ", CPF: "123"})
+		assert.Equal(t, []validator.FieldError{
+			{Field: "name", Tag: "required_trim", Value: " ", Message: "The field 'name' is required"},
+			{Field: "cpf", Tag: "cpf", Value: validator.RedactedValue, Message: "The field 'cpf' should be a valid cpf"},
+		}, validator.FieldErrors(err))
+	})
+
+	t.Run("validates each element of a slice", func(t *testing.T) {
+		inputs := []userInput{
+			{Name: "John", CPF: "52998224725"},
+			{Name: "Mary", CPF: "123"},
+		}
+		err := sv.ValidateStruct(&inputs)
+		assert.Equal(t, []validator.RowError{
+			{Row: 1, Errors: []validator.FieldError{
+				{Field: "[1].cpf", Tag: "cpf", Value: validator.RedactedValue, Message: "The field '[1].cpf' should be a valid cpf"},
+			}},
+		}, validator.RowErrors(err))
+	})
+
+	t.Run("stops the slice at the first invalid element with fail fast", func(t *testing.T) {
+		v, err := validator.NewValidator(validator.WithFailFast())
+		assert.NoError(t, err)
+
+		inputs := []userInput{{Name: "Mary", CPF: "123"}, {Name: "John"}}
+		err = New(v).ValidateStruct(&inputs)
+		assert.Equal(t, []validator.RowError{
+			{Row: 0, Errors: []validator.FieldError{
+				{Field: "[0].cpf", Tag: "cpf", Value: validator.RedactedValue, Message: "The field '[0].cpf' should be a valid cpf"},
+			}},
+		}, validator.RowErrors(err))
+	})
+
+	t.Run("skips other types", func(t *testing.T) {
+		assert.NoError(t, sv.ValidateStruct(nil))
+		assert.NoError(t, sv.ValidateStruct(map[string]string{"name": ""}))
+		assert.NoError(t, sv.ValidateStruct([]string{""}))
+	})
+
+	t.Run("engine is the validator", func(t *testing.T) {
+		assert.Equal(t, v, sv.Engine())
+	})
+}
+
+func TestErrorHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	v, err := validator.NewValidator()
+	assert.NoError(t, err)
+
+	defaultValidator := binding.Validator
+	binding.Validator = New(v)
+	t.Cleanup(func() {
+		binding.Validator = defaultValidator
+	})
+
+	router := gin.New()
+	router.Use(ErrorHandler())
+	router.POST("/users", func(c *gin.Context) {
+		input := userInput{}
+		if err := c.ShouldBindJSON(&input); err != nil {
+			_ = c.Error(err)
+			return
+		}
+		c.JSON(http.StatusCreated, input)
+	})
+	router.POST("/users/pt-br", func(c *gin.Context) {
+		c.Request = c.Request.WithContext(validator.ContextWithLocale(c.Request.Context(), validator.LocalePTBR))
+
+		input := userInput{}
+		if err := ShouldBindJSON(c, &input); err != nil {
+			_ = c.Error(err)
+			return
+		}
+		c.JSON(http.StatusCreated, input)
+	})
+	router.POST("/users/written", func(c *gin.Context) {
+		c.Status(http.StatusAccepted)
+		c.Writer.WriteHeaderNow()
+		_ = c.Error(c.ShouldBindJSON(&userInput{}))
+	})
+	router.GET("/fail", func(c *gin.Context) {
+		_ = c.Error(errors.New("not a rest error"))
+		c.Status(http.StatusTeapot)
+	})
+
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "valid input",
+			method:     http.MethodPost,
+			target:     "/users",
+			body:       `{"name": " John ", "cpf": "529.982.247-25"}`,
+			wantStatus: http.StatusCreated,
+			wantBody:   `{"name": "John", "cpf": "52998224725"}`,
+		},
+		{
+			name:       "renders the validation error",
+			method:     http.MethodPost,
+			target:     "/users",
+			body:       `{"name": "John"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody: `{"message": "Invalid input data", "status_code": 422, "error": "Unprocessable Entity", "causes": [[
+				{"field": "cpf", "tag": "required", "message": "The field 'cpf' is required"}
+			]]}`,
+		},
+		{
+			name:       "validates with the request context",
+			method:     http.MethodPost,
+			target:     "/users/pt-br",
+			body:       `{"name": "John"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody: `{"message": "Invalid input data", "status_code": 422, "error": "Unprocessable Entity", "causes": [[
+				{"field": "cpf", "tag": "required", "message": "O campo 'cpf' é obrigatório"}
+			]]}`,
+		},
+		{
+			name:       "errors are not rendered after the response is written",
+			method:     http.MethodPost,
+			target:     "/users/written",
+			body:       `{}`,
+			wantStatus: http.StatusAccepted,
+		},
+		{
+			name:       "other errors are not rendered",
+			method:     http.MethodGet,
+			target:     "/fail",
+			wantStatus: http.StatusTeapot,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body)))
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantBody == "" {
+				assert.Empty(t, rec.Body.String())
+				return
+			}
+			assert.JSONEq(t, tt.wantBody, rec.Body.String())
+		})
+	}
+}
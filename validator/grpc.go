@@ -0,0 +1,177 @@
+package validator
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// selfValidator is implemented by messages with their own validation, e.g. the ones generated by protoc-gen-validate
+type selfValidator interface {
+	Validate() error
+}
+
+// validateTagsCache caches, per message type and tag name, whether the type has validate tags
+var validateTagsCache sync.Map
+
+// validateTagsKey is the key of validateTagsCache
+type validateTagsKey struct {
+	typ reflect.Type
+	tag string
+}
+
+// tagNamer is implemented by the validators of NewValidator, which can read another tag (see WithTagName)
+type tagNamer interface {
+	validateTagName() string
+}
+
+// UnaryServerInterceptor returns a gRPC interceptor that validates the requests before calling the handler.
+// A request is validated with ValidateStruct when its type has validate tags (or the tag of WithTagName), e.g. added to the generated
+// structs with protoc-go-inject-tag (// @gotags: validate:"required,cpf"), and with its Validate() error
+// method when it has one. The errors are converted with resterrors.ToPb, so the clients can rebuild them
+// with resterrors.FromError, and the FieldError list is also attached as errdetails.BadRequest field violations.
+func UnaryServerInterceptor(v Validator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		err := validateMessage(ctx, v, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns a gRPC interceptor that validates each message received by the stream,
+// in the same way of UnaryServerInterceptor. The error is returned by RecvMsg.
+func StreamServerInterceptor(v Validator) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingServerStream{ServerStream: stream, validator: v})
+	}
+}
+
+type validatingServerStream struct {
+	grpc.ServerStream
+	validator Validator
+}
+
+func (s *validatingServerStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err != nil {
+		return err
+	}
+
+	return validateMessage(s.Context(), s.validator, m)
+}
+
+// validateMessage validates the message by its validate tags and its Validate method, returning a gRPC status error
+func validateMessage(ctx context.Context, v Validator, msg any) error {
+	tagName := defaultTagName
+	if namer, ok := v.(tagNamer); ok {
+		tagName = namer.validateTagName()
+	}
+
+	if hasValidateTags(reflect.TypeOf(msg), tagName) {
+		err := v.ValidateStruct(ctx, msg)
+		if err != nil {
+			return toGRPCError(err)
+		}
+	}
+
+	if sv, ok := msg.(selfValidator); ok {
+		err := sv.Validate()
+		if err != nil {
+			if _, isRestErr := err.(resterrors.RestErr); !isRestErr {
+				err = resterrors.NewUnprocessableEntity(err.Error())
+			}
+			return toGRPCError(err)
+		}
+	}
+
+	return nil
+}
+
+// toGRPCError converts the error with resterrors.ToPb and attaches the field errors as a BadRequest detail,
+// after the RestError detail read by resterrors.FromError.
+func toGRPCError(err error) error {
+	pbErr := resterrors.ToPb(err)
+
+	fieldErrors := FieldErrors(err)
+	if len(fieldErrors) == 0 {
+		return pbErr
+	}
+
+	st, ok := status.FromError(pbErr)
+	if !ok {
+		return pbErr
+	}
+
+	badRequest := &errdetails.BadRequest{}
+	for _, fieldErr := range fieldErrors {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       fieldErr.Field,
+			Description: fieldErr.Message,
+		})
+	}
+
+	withDetails, detailsErr := st.WithDetails(badRequest)
+	if detailsErr != nil {
+		return pbErr
+	}
+
+	return withDetails.Err()
+}
+
+// hasValidateTags reports whether the struct type, or any struct reachable from its fields, has the tag
+func hasValidateTags(typ reflect.Type, tagName string) bool {
+	typ = indirectType(typ)
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return false
+	}
+
+	key := validateTagsKey{typ: typ, tag: tagName}
+	if cached, ok := validateTagsCache.Load(key); ok {
+		return cached.(bool)
+	}
+
+	found := structHasValidateTags(typ, tagName, map[reflect.Type]bool{})
+	validateTagsCache.Store(key, found)
+	return found
+}
+
+func structHasValidateTags(typ reflect.Type, tagName string, visited map[reflect.Type]bool) bool {
+	if visited[typ] {
+		return false
+	}
+	visited[typ] = true
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		if _, ok := field.Tag.Lookup(tagName); ok {
+			return true
+		}
+
+		elem := indirectType(field.Type)
+		for elem.Kind() == reflect.Slice || elem.Kind() == reflect.Array || elem.Kind() == reflect.Map {
+			elem = indirectType(elem.Elem())
+		}
+
+		if elem.Kind() == reflect.Struct && structHasValidateTags(elem, tagName, visited) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (v *validatorImpl) validateTagName() string {
+	return v.tagName
+}
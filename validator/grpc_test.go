@@ -0,0 +1,176 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type grpcAddress struct {
+	ZipCode string `protobuf:"bytes,1,opt,name=zip_code,json=zipCode,proto3" json:"zip_code,omitempty" validate:"required,cep"`
+}
+
+type grpcCreateUserRequest struct {
+	Name    string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" validate:"required"`
+	Cpf     string       `protobuf:"bytes,2,opt,name=cpf,proto3" json:"cpf,omitempty" validate:"required,cpf"`
+	Address *grpcAddress `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+type grpcNestedTagsRequest struct {
+	Addresses []*grpcAddress `json:"addresses,omitempty" validate:"dive"`
+}
+
+type grpcSelfValidatedRequest struct {
+	Name string `json:"name,omitempty"`
+}
+
+func (r *grpcSelfValidatedRequest) Validate() error {
+	if r.Name == "" {
+		return errors.New("name is required")
+	}
+	return nil
+}
+
+type grpcUntaggedRequest struct {
+	Name string `json:"name,omitempty"`
+}
+
+func Test_hasValidateTags(t *testing.T) {
+	assert.True(t, hasValidateTags(reflect.TypeFor[grpcCreateUserRequest](), defaultTagName))
+	assert.True(t, hasValidateTags(reflect.TypeFor[*grpcNestedTagsRequest](), defaultTagName))
+	assert.False(t, hasValidateTags(reflect.TypeFor[grpcSelfValidatedRequest](), defaultTagName))
+	assert.False(t, hasValidateTags(reflect.TypeFor[string](), defaultTagName))
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	interceptor := UnaryServerInterceptor(v)
+	handler := func(ctx context.Context, req any) (any, error) {
+		return "ok", nil
+	}
+
+	t.Run("calls the handler when the request is valid", func(t *testing.T) {
+		res, err := interceptor(context.Background(), &grpcCreateUserRequest{Name: "John", Cpf: "52998224725"}, &grpc.UnaryServerInfo{}, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", res)
+	})
+
+	t.Run("returns the validation error with field violations", func(t *testing.T) {
+		req := &grpcCreateUserRequest{Name: "John", Cpf: "123", Address: &grpcAddress{}}
+		res, err := interceptor(context.Background(), req, &grpc.UnaryServerInfo{}, handler)
+		assert.Nil(t, res)
+
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Code(http.StatusUnprocessableEntity), st.Code())
+		assert.Len(t, st.Details(), 2)
+
+		badRequest, ok := st.Details()[1].(*errdetails.BadRequest)
+		assert.True(t, ok)
+		var violations []string
+		for _, violation := range badRequest.GetFieldViolations() {
+			violations = append(violations, violation.GetField()+": "+violation.GetDescription())
+		}
+		assert.Equal(t, []string{
+			"cpf: The field 'cpf' should be a valid cpf",
+			"address.zip_code: The field 'address.zip_code' is required",
+		}, violations)
+
+		restErr, ok := resterrors.FromError(err).(resterrors.RestErr)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, restErr.StatusCode())
+		assert.Equal(t, "Invalid input data", restErr.Message())
+	})
+
+	t.Run("uses the Validate method", func(t *testing.T) {
+		_, err := interceptor(context.Background(), &grpcSelfValidatedRequest{}, &grpc.UnaryServerInfo{}, handler)
+
+		restErr, ok := resterrors.FromError(err).(resterrors.RestErr)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusUnprocessableEntity, restErr.StatusCode())
+		assert.Equal(t, "name is required", restErr.Message())
+	})
+
+	t.Run("skips requests without validations", func(t *testing.T) {
+		res, err := interceptor(context.Background(), &grpcUntaggedRequest{}, &grpc.UnaryServerInfo{}, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", res)
+	})
+}
+
+type grpcBindingRequest struct {
+	Name string `json:"name,omitempty" binding:"required"`
+}
+
+func TestUnaryServerInterceptor_WithTagName(t *testing.T) {
+	v, err := NewValidator(WithTagName("binding"))
+	assert.NoError(t, err)
+
+	assert.True(t, hasValidateTags(reflect.TypeFor[grpcBindingRequest](), "binding"))
+	assert.False(t, hasValidateTags(reflect.TypeFor[grpcBindingRequest](), defaultTagName))
+
+	interceptor := UnaryServerInterceptor(v)
+	handler := func(ctx context.Context, req any) (any, error) {
+		return "ok", nil
+	}
+
+	res, err := interceptor(context.Background(), &grpcBindingRequest{}, &grpc.UnaryServerInfo{}, handler)
+	assert.Nil(t, res)
+	restErr, ok := resterrors.FromError(err).(resterrors.RestErr)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusUnprocessableEntity, restErr.StatusCode())
+
+	res, err = interceptor(context.Background(), &grpcBindingRequest{Name: "John"}, &grpc.UnaryServerInfo{}, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", res)
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	messages []*grpcCreateUserRequest
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *fakeServerStream) RecvMsg(m any) error {
+	*m.(*grpcCreateUserRequest) = *s.messages[0]
+	s.messages = s.messages[1:]
+	return nil
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	stream := &fakeServerStream{messages: []*grpcCreateUserRequest{
+		{Name: "John", Cpf: "52998224725"},
+		{Name: "", Cpf: "52998224725"},
+	}}
+
+	var recvErrs []error
+	err = StreamServerInterceptor(v)(nil, stream, &grpc.StreamServerInfo{}, func(srv any, stream grpc.ServerStream) error {
+		for i := 0; i < 2; i++ {
+			recvErrs = append(recvErrs, stream.RecvMsg(&grpcCreateUserRequest{}))
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, recvErrs[0])
+	st, ok := status.FromError(recvErrs[1])
+	assert.True(t, ok)
+	assert.Equal(t, codes.Code(http.StatusUnprocessableEntity), st.Code())
+}
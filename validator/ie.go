@@ -0,0 +1,350 @@
+package validator
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/diegoclair/go_utils/validator/brdocs"
+)
+
+// ieValidators has the state inscription (Inscrição Estadual) check for each state (UF),
+// following the algorithms published by SINTEGRA.
+var ieValidators = map[string]func(ie string) bool{
+	"AC": validIEAC,
+	"AL": validIEAL,
+	"AM": validIEAM,
+	"AP": validIEAP,
+	"BA": validIEBA,
+	"CE": validIEDescendingMod11,
+	"DF": validIEDF,
+	"ES": validIEModRest,
+	"GO": validIEGO,
+	"MA": validIEMA,
+	"MG": validIEMG,
+	"MS": validIEMS,
+	"MT": validIEMT,
+	"PA": validIEPA,
+	"PB": validIEDescendingMod11,
+	"PE": validIEPE,
+	"PI": validIEDescendingMod11,
+	"PR": validIEPR,
+	"RJ": validIERJ,
+	"RN": validIERN,
+	"RO": validIERO,
+	"RR": validIERR,
+	"RS": validIERS,
+	"SC": validIEModRest,
+	"SE": validIEDescendingMod11,
+	"SP": validIESP,
+	"TO": validIETO,
+}
+
+// validIE checks the state inscription of the given UF, with or without mask.
+// The check digits of the states are too similar to check an inscription without its UF, so it is invalid without one.
+func validIE(value, uf string) bool {
+	ie := brdocs.Unformat(value)
+	if ie == "" {
+		return false
+	}
+
+	valid, ok := ieValidators[strings.ToUpper(uf)]
+	return ok && valid(ie)
+}
+
+// isUF reports whether the value is the code of a brazilian state
+func isUF(value string) bool {
+	_, ok := ieValidators[strings.ToUpper(value)]
+	return ok
+}
+
+// ieDigits reports whether the inscription has the length, only digits and the prefix
+func ieDigits(ie string, length int, prefix string) bool {
+	return len(ie) == length && onlyDigits(ie) && strings.HasPrefix(ie, prefix)
+}
+
+// modRestDigit returns 0 when the rest of the sum by 11 is 0 or 1, otherwise 11 minus the rest
+func modRestDigit(sum int) int {
+	rest := sum % 11
+	if rest <= 1 {
+		return 0
+	}
+	return 11 - rest
+}
+
+// checkDigit compares the digit at the position with the expected value
+func checkDigit(ie string, position, expected int) bool {
+	return int(ie[position]-'0') == expected
+}
+
+// validIEDescendingMod11 is used by CE, PB, PI and SE: 9 digits, weights 9 to 2 and 11 minus the rest
+func validIEDescendingMod11(ie string) bool {
+	return ieDigits(ie, 9, "") && checkDigit(ie, 8, mod11Digit(weightedSum(ie, descendingWeights(9))))
+}
+
+// validIEModRest is used by ES and SC: 9 digits, weights 9 to 2 and 0 when the rest is 0 or 1
+func validIEModRest(ie string) bool {
+	return ieDigits(ie, 9, "") && checkDigit(ie, 8, modRestDigit(weightedSum(ie, descendingWeights(9))))
+}
+
+func validIEAC(ie string) bool {
+	return ieDigits(ie, 13, "01") &&
+		checkDigit(ie, 11, mod11Digit(weightedSum(ie, []int{4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}))) &&
+		checkDigit(ie, 12, mod11Digit(weightedSum(ie, []int{5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2})))
+}
+
+func validIEAL(ie string) bool {
+	// the third digit is the company type: 0 normal, 3 producer, 5 substitute, 7 micro, 8 special
+	if !ieDigits(ie, 9, "24") || !strings.ContainsRune("03578", rune(ie[2])) {
+		return false
+	}
+
+	digit := weightedSum(ie, descendingWeights(9)) * 10 % 11
+	if digit == 10 {
+		digit = 0
+	}
+	return checkDigit(ie, 8, digit)
+}
+
+func validIEAM(ie string) bool {
+	if !ieDigits(ie, 9, "") {
+		return false
+	}
+
+	sum := weightedSum(ie, descendingWeights(9))
+	if sum < 11 {
+		return checkDigit(ie, 8, 11-sum)
+	}
+	return checkDigit(ie, 8, modRestDigit(sum))
+}
+
+func validIEAP(ie string) bool {
+	if !ieDigits(ie, 9, "03") {
+		return false
+	}
+
+	// p and d depend on the range of the inscription
+	number, _ := strconv.Atoi(ie[:8])
+	p, d := 0, 0
+	switch {
+	case number <= 3017000:
+		p, d = 5, 0
+	case number <= 3019022:
+		p, d = 9, 1
+	}
+
+	digit := 11 - (p+weightedSum(ie, descendingWeights(9)))%11
+	switch digit {
+	case 10:
+		digit = 0
+	case 11:
+		digit = d
+	}
+	return checkDigit(ie, 8, digit)
+}
+
+func validIEBA(ie string) bool {
+	if len(ie) != 8 && len(ie) != 9 || !onlyDigits(ie) {
+		return false
+	}
+
+	// the inscriptions with 8 digits use the first digit to choose the module,
+	// the ones with 9 digits use the second digit
+	base := len(ie) - 2
+	typeDigit := ie[0]
+	if len(ie) == 9 {
+		typeDigit = ie[1]
+	}
+
+	digit := modRestDigit
+	if strings.ContainsRune("0123458", rune(typeDigit)) {
+		digit = func(sum int) int {
+			rest := sum % 10
+			if rest == 0 {
+				return 0
+			}
+			return 10 - rest
+		}
+	}
+
+	// the second check digit is calculated first and is used to calculate the first one
+	secondDigit := digit(weightedSum(ie, descendingWeights(base+1)))
+	withSecondDigit := ie[:base] + strconv.Itoa(secondDigit)
+	firstDigit := digit(weightedSum(withSecondDigit, descendingWeights(base+2)))
+
+	return checkDigit(ie, base, firstDigit) && checkDigit(ie, base+1, secondDigit)
+}
+
+func validIEDF(ie string) bool {
+	return ieDigits(ie, 13, "07") &&
+		checkDigit(ie, 11, mod11Digit(weightedSum(ie, []int{4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}))) &&
+		checkDigit(ie, 12, mod11Digit(weightedSum(ie, []int{5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2})))
+}
+
+func validIEGO(ie string) bool {
+	if !ieDigits(ie, 9, "") || !strings.HasPrefix(ie, "10") && !strings.HasPrefix(ie, "11") && !strings.HasPrefix(ie, "15") && ie[0] != '2' {
+		return false
+	}
+
+	rest := weightedSum(ie, descendingWeights(9)) % 11
+	digit := 11 - rest
+	switch rest {
+	case 0:
+		digit = 0
+	case 1:
+		digit = 0
+		if number, _ := strconv.Atoi(ie[:8]); number >= 10103105 && number <= 10119997 {
+			digit = 1
+		}
+	}
+	return checkDigit(ie, 8, digit)
+}
+
+func validIEMA(ie string) bool {
+	return ieDigits(ie, 9, "12") && checkDigit(ie, 8, modRestDigit(weightedSum(ie, descendingWeights(9))))
+}
+
+func validIEMG(ie string) bool {
+	if !ieDigits(ie, 13, "") {
+		return false
+	}
+
+	// the first digit uses the 11 first digits, with a 0 after the municipality code,
+	// multiplied by 1 and 2 alternately, summing the digits of the products
+	withZero := ie[:3] + "0" + ie[3:11]
+	sum := 0
+	for i := 0; i < len(withZero); i++ {
+		product := int(withZero[i]-'0') * (1 + i%2)
+		sum += product/10 + product%10
+	}
+	firstDigit := (10 - sum%10) % 10
+
+	secondDigit := modRestDigit(weightedSum(ie, []int{3, 2, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2}))
+
+	return checkDigit(ie, 11, firstDigit) && checkDigit(ie, 12, secondDigit)
+}
+
+func validIEMS(ie string) bool {
+	if !ieDigits(ie, 9, "28") && !ieDigits(ie, 9, "50") {
+		return false
+	}
+
+	rest := weightedSum(ie, descendingWeights(9)) % 11
+	digit := 0
+	if rest != 0 && 11-rest <= 9 {
+		digit = 11 - rest
+	}
+	return checkDigit(ie, 8, digit)
+}
+
+func validIEMT(ie string) bool {
+	if len(ie) < 11 && onlyDigits(ie) {
+		ie = strings.Repeat("0", 11-len(ie)) + ie
+	}
+
+	return ieDigits(ie, 11, "") && checkDigit(ie, 10, modRestDigit(weightedSum(ie, []int{3, 2, 9, 8, 7, 6, 5, 4, 3, 2})))
+}
+
+func validIEPA(ie string) bool {
+	return ieDigits(ie, 9, "15") && checkDigit(ie, 8, modRestDigit(weightedSum(ie, descendingWeights(9))))
+}
+
+func validIEPE(ie string) bool {
+	// the current eFisco format has 9 digits and two check digits
+	if ieDigits(ie, 9, "") {
+		return checkDigit(ie, 7, modRestDigit(weightedSum(ie, descendingWeights(8)))) &&
+			checkDigit(ie, 8, modRestDigit(weightedSum(ie, descendingWeights(9))))
+	}
+
+	// the old format has 14 digits and one check digit
+	if !ieDigits(ie, 14, "") {
+		return false
+	}
+
+	digit := 11 - weightedSum(ie, []int{5, 4, 3, 2, 1, 9, 8, 7, 6, 5, 4, 3, 2})%11
+	if digit > 9 {
+		digit -= 10
+	}
+	return checkDigit(ie, 13, digit)
+}
+
+func validIEPR(ie string) bool {
+	return ieDigits(ie, 10, "") &&
+		checkDigit(ie, 8, modRestDigit(weightedSum(ie, []int{3, 2, 7, 6, 5, 4, 3, 2}))) &&
+		checkDigit(ie, 9, modRestDigit(weightedSum(ie, []int{4, 3, 2, 7, 6, 5, 4, 3, 2})))
+}
+
+func validIERJ(ie string) bool {
+	return ieDigits(ie, 8, "") && checkDigit(ie, 7, modRestDigit(weightedSum(ie, []int{2, 7, 6, 5, 4, 3, 2})))
+}
+
+func validIERN(ie string) bool {
+	if !ieDigits(ie, 9, "20") && !ieDigits(ie, 10, "20") {
+		return false
+	}
+
+	base := len(ie) - 1
+	digit := weightedSum(ie, descendingWeights(base+1)) * 10 % 11
+	if digit == 10 {
+		digit = 0
+	}
+	return checkDigit(ie, base, digit)
+}
+
+func validIERO(ie string) bool {
+	roDigit := func(sum int) int {
+		digit := 11 - sum%11
+		if digit > 9 {
+			digit -= 10
+		}
+		return digit
+	}
+
+	// the old format has 9 digits, where the first 3 are the municipality and are not used in the check digit
+	if ieDigits(ie, 9, "") {
+		return checkDigit(ie, 8, roDigit(weightedSum(ie[3:], descendingWeights(6))))
+	}
+
+	return ieDigits(ie, 14, "") && checkDigit(ie, 13, roDigit(weightedSum(ie, []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2})))
+}
+
+func validIERR(ie string) bool {
+	return ieDigits(ie, 9, "24") && checkDigit(ie, 8, weightedSum(ie, []int{1, 2, 3, 4, 5, 6, 7, 8})%9)
+}
+
+func validIERS(ie string) bool {
+	return ieDigits(ie, 10, "") && checkDigit(ie, 9, mod11Digit(weightedSum(ie, []int{2, 9, 8, 7, 6, 5, 4, 3, 2})))
+}
+
+func validIESP(ie string) bool {
+	spDigit := func(digits string, weights []int) int {
+		return weightedSum(digits, weights) % 11 % 10
+	}
+	firstWeights := []int{1, 3, 4, 5, 6, 7, 8, 10}
+
+	// rural producers have the format P-0MMMSSSSD.D/NNN, with one check digit after the first 8 digits
+	if strings.HasPrefix(ie, "P") {
+		digits := ie[1:]
+		return ieDigits(digits, 12, "") && checkDigit(digits, 8, spDigit(digits, firstWeights))
+	}
+
+	return ieDigits(ie, 12, "") &&
+		checkDigit(ie, 8, spDigit(ie, firstWeights)) &&
+		checkDigit(ie, 11, spDigit(ie, []int{3, 2, 10, 9, 8, 7, 6, 5, 4, 3, 2}))
+}
+
+// ieTOCompanyTypes are the company types of the old format of TO
+var ieTOCompanyTypes = map[string]bool{"01": true, "02": true, "03": true, "99": true}
+
+func validIETO(ie string) bool {
+	// the new format has 9 digits
+	if ieDigits(ie, 9, "") {
+		return checkDigit(ie, 8, modRestDigit(weightedSum(ie, descendingWeights(9))))
+	}
+
+	// the old format has 11 digits, where the 3rd and 4th are the company type and are not used in the check digit
+	if !ieDigits(ie, 11, "") || !ieTOCompanyTypes[ie[2:4]] {
+		return false
+	}
+
+	return checkDigit(ie, 10, modRestDigit(weightedSum(ie[:2]+ie[4:], descendingWeights(9))))
+}
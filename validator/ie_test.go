@@ -0,0 +1,98 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validIE(t *testing.T) {
+	tests := []struct {
+		name string
+		uf   string
+		ie   string
+		want bool
+	}{
+		{name: "AC valid", uf: "AC", ie: "01.004.823/001-12", want: true},
+		{name: "AC wrong check digit", uf: "AC", ie: "0100482300113", want: false},
+		{name: "AC wrong prefix", uf: "AC", ie: "0200482300112", want: false},
+		{name: "AL valid", uf: "AL", ie: "240000048", want: true},
+		{name: "AL wrong check digit", uf: "AL", ie: "240000049", want: false},
+		{name: "AL wrong company type", uf: "AL", ie: "241000048", want: false},
+		{name: "AP valid", uf: "AP", ie: "030123459", want: true},
+		{name: "AP wrong check digit", uf: "AP", ie: "030123458", want: false},
+		{name: "AM valid", uf: "AM", ie: "04.293.368-4", want: true},
+		{name: "AM wrong check digit", uf: "AM", ie: "042933685", want: false},
+		{name: "BA valid with 8 digits and module 10", uf: "BA", ie: "123456-63", want: true},
+		{name: "BA valid with 8 digits and module 11", uf: "BA", ie: "61234557", want: true},
+		{name: "BA valid with 9 digits", uf: "BA", ie: "1000003-06", want: true},
+		{name: "BA wrong first check digit", uf: "BA", ie: "12345673", want: false},
+		{name: "BA wrong second check digit", uf: "BA", ie: "100000307", want: false},
+		{name: "CE valid", uf: "CE", ie: "06000001-5", want: true},
+		{name: "CE wrong check digit", uf: "CE", ie: "060000016", want: false},
+		{name: "DF valid", uf: "DF", ie: "07300001001-09", want: true},
+		{name: "DF wrong check digit", uf: "DF", ie: "0730000100108", want: false},
+		{name: "GO valid", uf: "GO", ie: "10.987.654-7", want: true},
+		{name: "GO wrong check digit", uf: "GO", ie: "109876548", want: false},
+		{name: "GO wrong prefix", uf: "GO", ie: "129876547", want: false},
+		{name: "MA valid", uf: "MA", ie: "120000385", want: true},
+		{name: "MA wrong check digit", uf: "MA", ie: "120000386", want: false},
+		{name: "MT valid", uf: "MT", ie: "0013000001-9", want: true},
+		{name: "MT valid without leading zeros", uf: "MT", ie: "130000019", want: true},
+		{name: "MT wrong check digit", uf: "MT", ie: "00130000018", want: false},
+		{name: "MS valid", uf: "MS", ie: "283115947", want: true},
+		{name: "MS wrong check digit", uf: "MS", ie: "283115948", want: false},
+		{name: "MG valid", uf: "MG", ie: "062.307.904/0081", want: true},
+		{name: "MG wrong first check digit", uf: "MG", ie: "0623079040091", want: false},
+		{name: "MG wrong second check digit", uf: "MG", ie: "0623079040082", want: false},
+		{name: "PA valid", uf: "PA", ie: "15-999999-5", want: true},
+		{name: "PA wrong check digit", uf: "PA", ie: "159999996", want: false},
+		{name: "PB valid", uf: "PB", ie: "06000001-5", want: true},
+		{name: "PB wrong check digit", uf: "PB", ie: "060000014", want: false},
+		{name: "PR valid", uf: "PR", ie: "123.45678-50", want: true},
+		{name: "PR wrong first check digit", uf: "PR", ie: "1234567860", want: false},
+		{name: "PR wrong second check digit", uf: "PR", ie: "1234567851", want: false},
+		{name: "PE valid", uf: "PE", ie: "0321418-40", want: true},
+		{name: "PE valid in the old format", uf: "PE", ie: "18.1.001.0000004-9", want: true},
+		{name: "PE wrong check digit", uf: "PE", ie: "032141841", want: false},
+		{name: "PE wrong check digit in the old format", uf: "PE", ie: "18100100000048", want: false},
+		{name: "PI valid", uf: "PI", ie: "012345679", want: true},
+		{name: "PI wrong check digit", uf: "PI", ie: "012345678", want: false},
+		{name: "RJ valid", uf: "RJ", ie: "99.999.99-3", want: true},
+		{name: "RJ wrong check digit", uf: "RJ", ie: "99999994", want: false},
+		{name: "RN valid with 9 digits", uf: "RN", ie: "20.040.040-1", want: true},
+		{name: "RN valid with 10 digits", uf: "RN", ie: "20.0.040.040-0", want: true},
+		{name: "RN wrong check digit", uf: "RN", ie: "200400402", want: false},
+		{name: "RS valid", uf: "RS", ie: "224/3658792", want: true},
+		{name: "RS wrong check digit", uf: "RS", ie: "2243658793", want: false},
+		{name: "RO valid", uf: "RO", ie: "0000000062521-3", want: true},
+		{name: "RO valid in the old format", uf: "RO", ie: "101.62521-3", want: true},
+		{name: "RO wrong check digit", uf: "RO", ie: "00000000625214", want: false},
+		{name: "RR valid", uf: "RR", ie: "24006628-1", want: true},
+		{name: "RR wrong check digit", uf: "RR", ie: "240066282", want: false},
+		{name: "SC valid", uf: "SC", ie: "251.040.852", want: true},
+		{name: "SC wrong check digit", uf: "SC", ie: "251040853", want: false},
+		{name: "SP valid", uf: "SP", ie: "110.042.490.114", want: true},
+		{name: "SP valid rural producer", uf: "SP", ie: "P-01100424.3/002", want: true},
+		{name: "SP wrong first check digit", uf: "SP", ie: "110042491114", want: false},
+		{name: "SP wrong second check digit", uf: "SP", ie: "110042490115", want: false},
+		{name: "SP wrong rural producer check digit", uf: "SP", ie: "P011004244002", want: false},
+		{name: "SE valid", uf: "SE", ie: "27123456-3", want: true},
+		{name: "SE wrong check digit", uf: "SE", ie: "271234564", want: false},
+		{name: "TO valid", uf: "TO", ie: "29.01.022783-6", want: true},
+		{name: "TO wrong check digit", uf: "TO", ie: "29010227837", want: false},
+		{name: "TO wrong company type", uf: "TO", ie: "29040227836", want: false},
+		{name: "TO company type between the valid ones", uf: "TO", ie: "29100227836", want: false},
+		{name: "lowercase uf", uf: "sp", ie: "110042490114", want: true},
+		{name: "valid in another state", uf: "RJ", ie: "110042490114", want: false},
+		{name: "unknown uf", uf: "XX", ie: "110042490114", want: false},
+		{name: "without uf", ie: "110042490114", want: false},
+		{name: "empty", uf: "SP", ie: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, validIE(tt.ie, tt.uf))
+		})
+	}
+}
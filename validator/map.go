@@ -0,0 +1,234 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/go-playground/validator/v10"
+)
+
+// MapSelfKey is the key of the nested rules of ValidateMap with the tags of the value itself.
+// Without dive, the value is an object validated by the other keys of the rules, e.g.
+//
+//	"address": map[string]any{"$": "required", "zip_code": "required,cep"}
+//
+// With dive, the value is an array and each element is validated by the tags after dive
+// and, when it is an object, by the other keys of the rules, e.g.
+//
+//	"items": map[string]any{"$": "required,min=1,dive,required", "sku": "required", "quantity": "min=1"}
+const MapSelfKey = "$"
+
+func (v *validatorImpl) ValidateMap(ctx context.Context, data map[string]any, rules map[string]any) error {
+	ctx, cancel := v.budgetContext(ctx)
+	defer cancel()
+
+	ctx, state := contextWithValidationState(ctx)
+
+	fieldErrors, err := v.validateMapRules(ctx, state, v.locale(ctx), "", data, rules)
+	if err != nil {
+		return resterrors.NewInternalServerError("Invalid validation rules: "+err.Error(), err)
+	}
+
+	// the errors of the validations registered with RegisterValidationCtx are not invalid fields
+	if validationErr := state.err(); validationErr != nil {
+		return resterrors.NewInternalServerError("Error trying to validate the input data", validationErr)
+	}
+
+	if len(fieldErrors) == 0 {
+		return nil
+	}
+
+	return v.invalidInputError(v.limitFieldErrors(fieldErrors))
+}
+
+// validateMapRules validates the keys of the object with the rules, sorted by key so the errors have a stable order.
+// The keys of the object without rules are not validated.
+func (v *validatorImpl) validateMapRules(ctx context.Context, state *validationState, locale, prefix string, object map[string]any, rules map[string]any) ([]FieldError, error) {
+	var fieldErrors []FieldError
+	for _, key := range slices.Sorted(maps.Keys(rules)) {
+		if key == MapSelfKey {
+			continue
+		}
+
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+
+		errs, err := v.validateMapValue(ctx, state, locale, path, object[key], rules[key])
+		if err != nil {
+			return nil, err
+		}
+		fieldErrors = append(fieldErrors, errs...)
+	}
+
+	return fieldErrors, nil
+}
+
+// validateMapValue validates the value at the path with its rule, a tag string or a map of nested rules
+func (v *validatorImpl) validateMapValue(ctx context.Context, state *validationState, locale, path string, value, rule any) ([]FieldError, error) {
+	switch rule := rule.(type) {
+	case string:
+		return v.validateMapVar(ctx, state, locale, path, value, rule)
+
+	case map[string]any:
+		self, ok := rule[MapSelfKey].(string)
+		if !ok && rule[MapSelfKey] != nil {
+			return nil, fmt.Errorf("the %s rule of %s should be a string, got %T", MapSelfKey, path, rule[MapSelfKey])
+		}
+
+		valueTags, elementTags, dive := cutDive(self)
+
+		// the nested rules are not checked when the value itself is invalid or is absent
+		fieldErrors, err := v.validateMapVar(ctx, state, locale, path, value, valueTags)
+		if err != nil || len(fieldErrors) > 0 || value == nil {
+			return fieldErrors, err
+		}
+
+		if !dive {
+			object, ok := value.(map[string]any)
+			if !ok {
+				return []FieldError{v.mapTypeError(locale, path, "object")}, nil
+			}
+			return v.validateMapRules(ctx, state, locale, path, object, rule)
+		}
+
+		array, ok := value.([]any)
+		if !ok {
+			return []FieldError{v.mapTypeError(locale, path, "array")}, nil
+		}
+
+		hasNestedRules := len(rule) > 1
+		for i, element := range array {
+			elementPath := path + "[" + strconv.Itoa(i) + "]"
+
+			errs, err := v.validateMapVar(ctx, state, locale, elementPath, element, elementTags)
+			if err != nil {
+				return nil, err
+			}
+			if len(errs) > 0 || element == nil || !hasNestedRules {
+				fieldErrors = append(fieldErrors, errs...)
+				continue
+			}
+
+			object, ok := element.(map[string]any)
+			if !ok {
+				fieldErrors = append(fieldErrors, v.mapTypeError(locale, elementPath, "object"))
+				continue
+			}
+
+			errs, err = v.validateMapRules(ctx, state, locale, elementPath, object, rule)
+			if err != nil {
+				return nil, err
+			}
+			fieldErrors = append(fieldErrors, errs...)
+		}
+
+		return fieldErrors, nil
+	}
+
+	return nil, fmt.Errorf("the rule of %s should be a string or a map, got %T", path, rule)
+}
+
+// validateMapVar validates the value at the path with the tags. The tags after dive validate the elements of an array
+// or the values of an object, since go-playground does not validate the elements of []any when it dives.
+func (v *validatorImpl) validateMapVar(ctx context.Context, state *validationState, locale, path string, value any, tags string) ([]FieldError, error) {
+	valueTags, elementTags, dive := cutDive(tags)
+
+	fieldErrors, err := v.validateMapTags(ctx, state, locale, path, value, valueTags)
+	if err != nil || len(fieldErrors) > 0 || !dive {
+		return fieldErrors, err
+	}
+
+	elements := map[string]any{}
+	switch value := value.(type) {
+	case []any:
+		for i, element := range value {
+			elements["["+strconv.Itoa(i)+"]"] = element
+		}
+	case map[string]any:
+		for key, element := range value {
+			elements["["+key+"]"] = element
+		}
+	default:
+		return nil, nil
+	}
+
+	for _, index := range slices.SortedFunc(maps.Keys(elements), compareIndexes) {
+		errs, err := v.validateMapVar(ctx, state, locale, path+index, elements[index], elementTags)
+		if err != nil {
+			return nil, err
+		}
+		fieldErrors = append(fieldErrors, errs...)
+	}
+
+	return fieldErrors, nil
+}
+
+// validateMapTags validates the value at the path with the tags, without dive
+func (v *validatorImpl) validateMapTags(ctx context.Context, state *validationState, locale, path string, value any, tags string) (fieldErrors []FieldError, err error) {
+	if tags == "" {
+		return nil, nil
+	}
+
+	// go-playground panics on the tags it does not know, which can come from rules loaded at runtime
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("the rule of %s is invalid: %v", path, r)
+		}
+	}()
+
+	err = v.validator.VarCtx(ctx, value, escapePixKeyGroups(tags))
+	if err == nil {
+		return nil, nil
+	}
+
+	errs, ok := err.(validator.ValidationErrors)
+	if !ok {
+		return nil, err
+	}
+
+	sensitive := hasSensitiveTag(tags)
+	for _, fieldErr := range errs {
+		value := fieldErrorValue(fieldErr.Value(), sensitive || isSensitiveTag(fieldErr.Tag()))
+		fieldErrors = v.appendFieldErrors(fieldErrors, state, locale, path, fieldErr.Param(), value, fieldErr)
+	}
+
+	return fieldErrors, nil
+}
+
+// compareIndexes sorts the indexes of the elements, e.g. [2] and [10], by number when both are array indexes
+func compareIndexes(a, b string) int {
+	i, errA := strconv.Atoi(strings.Trim(a, "[]"))
+	j, errB := strconv.Atoi(strings.Trim(b, "[]"))
+	if errA == nil && errB == nil {
+		return i - j
+	}
+	return strings.Compare(a, b)
+}
+
+// mapTypeError returns the error of a value that is not of the type expected by the nested rules, object or array
+func (v *validatorImpl) mapTypeError(locale, path, typeName string) FieldError {
+	return FieldError{
+		Field:   path,
+		Tag:     "type",
+		Param:   typeName,
+		Message: v.messages.Message(locale, "type", path, typeName),
+	}
+}
+
+// cutDive splits the tags at the first dive, into the tags of the array and the tags of its elements
+func cutDive(tags string) (arrayTags, elementTags string, found bool) {
+	rules := strings.Split(tags, ",")
+	for i, rule := range rules {
+		if strings.TrimSpace(rule) == "dive" {
+			return strings.Join(rules[:i], ","), strings.Join(rules[i+1:], ","), true
+		}
+	}
+	return tags, "", false
+}
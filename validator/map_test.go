@@ -0,0 +1,133 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validatorImpl_ValidateMap(t *testing.T) {
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	// the rules can be loaded from JSON or YAML
+	var rules map[string]any
+	err = json.Unmarshal([]byte(`{
+		"name": "required,min=3",
+		"document": "required,cpf|cnpj",
+		"email": "omitempty,email",
+		"phones": "omitempty,max=2,dive,e164",
+		"address": {"$": "required", "zip_code": "required,cep", "state": "required,len=2"},
+		"items": {"$": "required,min=1,dive,required", "sku": "required", "quantity": "required,min=1"},
+		"labels": "omitempty,dive,max=5",
+		"password": "omitempty,password"
+	}`), &rules)
+	assert.NoError(t, err)
+
+	valid := `{
+		"name": "John",
+		"document": "12.ABC.345/01DE-35",
+		"phones": ["+5511912345678"],
+		"address": {"zip_code": "01310-100", "state": "SP"},
+		"items": [{"sku": "A1", "quantity": 2}],
+		"extra": "not validated"
+	}`
+
+	tests := []struct {
+		name       string
+		data       string
+		wantFields []string
+	}{
+		{name: "valid", data: valid},
+		{
+			name:       "missing fields",
+			data:       `{}`,
+			wantFields: []string{"address:required", "document:required", "items:required", "name:required"},
+		},
+		{
+			name: "invalid values",
+			data: `{
+				"name": "Jo",
+				"document": "529.982.247-26",
+				"email": "john",
+				"phones": ["+5511912345678", "11912345678"],
+				"address": {"zip_code": "123", "state": "SP"},
+				"items": [{"sku": "A1", "quantity": 0}, null, {"quantity": 1}],
+				"labels": {"team": "core", "environment": "production"}
+			}`,
+			wantFields: []string{
+				"address.zip_code:cep", "document:cpf|cnpj", "email:email", "items[0].quantity:required", "items[1]:required",
+				"items[2].sku:required", "labels[environment]:max", "name:min", "phones[1]:e164",
+			},
+		},
+		{
+			name:       "values of the wrong type",
+			data:       `{"name": "John", "document": "529.982.247-25", "address": "Av. Paulista", "items": {"sku": "A1"}}`,
+			wantFields: []string{"address:type", "items:type"},
+		},
+		{
+			name:       "elements of the wrong type",
+			data:       `{"name": "John", "document": "529.982.247-25", "address": {"zip_code": "01310-100", "state": "SP"}, "items": ["A1"]}`,
+			wantFields: []string{"items[0]:type"},
+		},
+		{
+			name: "array indexes are sorted by number",
+			data: `{"name": "John", "document": "529.982.247-25", "address": {"zip_code": "01310-100", "state": "SP"}, "items": [{"sku": "1", "quantity": 1}, {"sku": "2", "quantity": 1}, {"sku": "3", "quantity": 1}, {"sku": "4", "quantity": 1}, {"sku": "5", "quantity": 1}, {"sku": "6", "quantity": 1}, {"sku": "7", "quantity": 1}, {"sku": "8", "quantity": 1}, {"sku": "9", "quantity": 1}, {"sku": "10", "quantity": 1}, {"sku": "11", "quantity": 1}], "phones": []}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var data map[string]any
+			assert.NoError(t, json.Unmarshal([]byte(tt.data), &data))
+
+			err := v.ValidateMap(context.Background(), data, rules)
+			if tt.wantFields == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			restErr, ok := err.(resterrors.RestErr)
+			assert.True(t, ok)
+			assert.Equal(t, http.StatusUnprocessableEntity, restErr.StatusCode())
+
+			var fields []string
+			for _, fieldErr := range FieldErrors(err) {
+				fields = append(fields, fieldErr.Field+":"+fieldErr.Tag)
+			}
+			assert.Equal(t, tt.wantFields, fields)
+		})
+	}
+
+	t.Run("messages, values and password rules", func(t *testing.T) {
+		data := map[string]any{"document": "52998224726", "email": "john", "password": "abc"}
+		rules := map[string]any{"document": "cpf", "email": "email", "password": "password"}
+
+		err := v.ValidateMap(ContextWithLocale(context.Background(), LocalePTBR), data, rules)
+		assert.Equal(t, []FieldError{
+			{Field: "document", Tag: "cpf", Value: RedactedValue, Message: "O campo 'document' deve ser um cpf válido"},
+			{Field: "email", Tag: "email", Value: "john", Message: "O campo 'email' deve ser um e-mail válido"},
+			{Field: "password", Tag: "password", Code: PasswordMinLength, Param: "8", Value: RedactedValue, Message: "O campo 'password' deve ter no mínimo 8 caracteres"},
+			{Field: "password", Tag: "password", Code: PasswordUpper, Value: RedactedValue, Message: "O campo 'password' deve ter uma letra maiúscula"},
+			{Field: "password", Tag: "password", Code: PasswordDigit, Value: RedactedValue, Message: "O campo 'password' deve ter um número"},
+		}, FieldErrors(err))
+	})
+
+	t.Run("invalid rules", func(t *testing.T) {
+		for _, rules := range []map[string]any{
+			{"name": "required,unknown_tag"},
+			{"name": 10},
+			{"address": map[string]any{"$": true}},
+		} {
+			err := v.ValidateMap(context.Background(), map[string]any{"name": "John"}, rules)
+
+			restErr, ok := err.(resterrors.RestErr)
+			assert.True(t, ok)
+			assert.Equal(t, http.StatusInternalServerError, restErr.StatusCode())
+		}
+	})
+}
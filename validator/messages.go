@@ -0,0 +1,143 @@
+package validator
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// Locales with a built-in catalog of messages
+const (
+	LocaleEN   = "en"
+	LocalePTBR = "pt-BR"
+)
+
+// DefaultLocale is the locale used when the context has no locale
+const DefaultLocale = LocaleEN
+
+// fallbackTag is the catalog key of the message used for tags without a message
+const fallbackTag = ""
+
+type localeKey struct{}
+
+// ContextWithLocale returns a copy of ctx carrying the locale used to build the validation messages, e.g. pt-BR.
+func ContextWithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeKey{}, locale)
+}
+
+// LocaleFromContext returns the locale set by ContextWithLocale, or an empty string if there is none.
+func LocaleFromContext(ctx context.Context) string {
+	locale, _ := ctx.Value(localeKey{}).(string)
+	return locale
+}
+
+// Messages is a registry of message templates per locale and tag.
+// The templates can use the placeholders {field}, with the path of the field, {param}, with the param of the tag,
+// and {value}, with the value of the field, which is RedactedValue for the sensitive fields.
+// It is safe for concurrent use.
+type Messages struct {
+	mu            sync.RWMutex
+	defaultLocale string
+	templates     map[string]map[string]string
+}
+
+// NewMessages returns a registry with the built-in en and pt-BR catalogs.
+func NewMessages() *Messages {
+	m := &Messages{
+		defaultLocale: DefaultLocale,
+		templates:     make(map[string]map[string]string),
+	}
+
+	for tag, template := range messagesEN {
+		m.Register(LocaleEN, tag, template)
+	}
+	for tag, template := range messagesPTBR {
+		m.Register(LocalePTBR, tag, template)
+	}
+
+	return m
+}
+
+// Register adds or replaces the template of the tag for the locale.
+// Use an empty tag to set the message used for the tags that have no template.
+func (m *Messages) Register(locale, tag, template string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	locale = normalizeLocale(locale)
+	if m.templates[locale] == nil {
+		m.templates[locale] = make(map[string]string)
+	}
+	m.templates[locale][tag] = template
+}
+
+// Message returns the message of the tag for the field in the given locale.
+// When the locale has no template for the tag, the message falls back to the generic message
+// of the locale and then to the default locale.
+func (m *Messages) Message(locale, tag, field, param string) string {
+	return m.MessageWithValue(locale, tag, field, param, "")
+}
+
+// MessageWithValue returns the message like Message, replacing the {value} placeholder with the value.
+// The value of a sensitive field should be RedactedValue, never the raw value.
+func (m *Messages) MessageWithValue(locale, tag, field, param, value string) string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	template, ok := m.lookup(m.matchLocale(locale), tag)
+	if !ok {
+		template, _ = m.lookup(m.defaultLocale, tag)
+	}
+
+	return strings.NewReplacer("{field}", field, "{param}", param, "{value}", value).Replace(template)
+}
+
+func (m *Messages) lookup(locale, tag string) (string, bool) {
+	templates, ok := m.templates[locale]
+	if !ok {
+		return "", false
+	}
+
+	if template, ok := templates[tag]; ok {
+		return template, true
+	}
+
+	template, ok := templates[fallbackTag]
+	return template, ok
+}
+
+// matchLocale returns the registered locale that best matches the given one:
+// the exact locale (case insensitive, accepting _ as separator), then the base language, e.g. pt for pt-PT.
+func (m *Messages) matchLocale(locale string) string {
+	locale = normalizeLocale(locale)
+	if locale == "" {
+		return m.defaultLocale
+	}
+
+	if _, ok := m.templates[locale]; ok {
+		return locale
+	}
+
+	match := ""
+	base, _, _ := strings.Cut(locale, "-")
+	for registered := range m.templates {
+		registeredBase, _, _ := strings.Cut(registered, "-")
+		if registeredBase == base && (match == "" || registered < match) {
+			match = registered
+		}
+	}
+
+	if match == "" {
+		return m.defaultLocale
+	}
+	return match
+}
+
+// normalizeLocale formats the locale as language-REGION, e.g. pt_br to pt-BR
+func normalizeLocale(locale string) string {
+	language, region, found := strings.Cut(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"), "-")
+	if !found {
+		return strings.ToLower(language)
+	}
+	return strings.ToLower(language) + "-" + strings.ToUpper(region)
+}
@@ -0,0 +1,262 @@
+package validator
+
+// messagesEN is the built-in english catalog
+var messagesEN = map[string]string{
+	fallbackTag: "The field '{field}' is invalid.",
+
+	// custom tags
+	"required_trim":  "The field '{field}' is required",
+	"cpf":            "The field '{field}' should be a valid cpf",
+	"cnpj":           "The field '{field}' should be a valid cnpj",
+	"cep":            "The field '{field}' should be a valid cep",
+	"cnh":            "The field '{field}' should be a valid cnh",
+	"renavam":        "The field '{field}' should be a valid renavam",
+	"pis":            "The field '{field}' should be a valid pis/pasep/nit",
+	"titulo_eleitor": "The field '{field}' should be a valid voter registration (título de eleitor)",
+	"plate":          "The field '{field}' should be a valid vehicle plate",
+	"ie":             "The field '{field}' should be a valid state inscription",
+	"cns":            "The field '{field}' should be a valid cns",
+	"pix_key":        "The field '{field}' should be a valid pix key",
+	"evp":            "The field '{field}' should be a valid pix random key",
+	"bank_agency":    "The field '{field}' should be a valid bank agency",
+	"bank_account":   "The field '{field}' should be a valid bank account",
+	"boleto":         "The field '{field}' should be a valid boleto",
+	"phone":          "The field '{field}' should be a valid phone number",
+	"required_when":  "The field '{field}' is required when {param}",
+	"excluded_when":  "The field '{field}' should not be present when {param}",
+
+	// password rules, see PasswordPolicy
+	"password":            "The field '{field}' should be a strong password",
+	"password_min_length": "The field '{field}' should have at least {param} characters",
+	"password_max_length": "The field '{field}' should have at most {param} characters",
+	"password_upper":      "The field '{field}' should have an uppercase letter",
+	"password_lower":      "The field '{field}' should have a lowercase letter",
+	"password_digit":      "The field '{field}' should have a digit",
+	"password_symbol":     "The field '{field}' should have a symbol",
+	"password_repeated":   "The field '{field}' should not repeat a character more than {param} times in a row",
+	"password_common":     "The field '{field}' is a common password",
+	"password_user_info":  "The field '{field}' should not contain the email or the name",
+
+	// uploaded files
+	"max_size":       "The file '{field}' should have at most {param}",
+	"mime":           "The file '{field}' should be of the type {param}",
+	"ext":            "The file '{field}' should have the extension {param}",
+	"min_dimensions": "The image '{field}' should have at least {param} pixels",
+	"max_dimensions": "The image '{field}' should have at most {param} pixels",
+
+	// binding, see Bind
+	"type":          "The field '{field}' should be of type {param}",
+	"unknown_field": "The field '{field}' is not allowed",
+
+	// required and excluded
+	"required":             "The field '{field}' is required",
+	"required_if":          "The field '{field}' is required when {param}",
+	"required_unless":      "The field '{field}' is required unless {param}",
+	"required_with":        "The field '{field}' is required when {param} is present",
+	"required_with_all":    "The field '{field}' is required when all of {param} are present",
+	"required_without":     "The field '{field}' is required when {param} is not present",
+	"required_without_all": "The field '{field}' is required when none of {param} are present",
+	"excluded_if":          "The field '{field}' should not be present when {param}",
+	"excluded_unless":      "The field '{field}' should not be present unless {param}",
+	"excluded_with":        "The field '{field}' should not be present when {param} is present",
+	"excluded_with_all":    "The field '{field}' should not be present when all of {param} are present",
+	"excluded_without":     "The field '{field}' should not be present when {param} is not present",
+	"excluded_without_all": "The field '{field}' should not be present when none of {param} are present",
+	"isdefault":            "The field '{field}' should be empty",
+
+	// comparisons
+	"len":            "The field '{field}' should have the length or value: {param}",
+	"min":            "The field '{field}' should have the minimum length or value: {param}",
+	"max":            "The field '{field}' should have the max length or value: {param}",
+	"eq":             "The value '{field}' should be equal to the {param}",
+	"eq_ignore_case": "The value '{field}' should be equal to the {param}, ignoring case",
+	"ne":             "The value '{field}' should not be equal to the {param}",
+	"ne_ignore_case": "The value '{field}' should not be equal to the {param}, ignoring case",
+	"lt":             "The field '{field}' should be less than {param}",
+	"lte":            "The field '{field}' should be less than or equal {param}",
+	"gt":             "The field '{field}' should be greater than {param}",
+	"gte":            "The field '{field}' should be greater than or equal {param}",
+	"oneof":          "The field '{field}' should be one of: {param}",
+	"unique":         "The field '{field}' should contain unique values",
+
+	// comparisons with other fields
+	"eqfield":       "The field '{field}' should be equal to the field {param}",
+	"nefield":       "The field '{field}' should not be equal to the field {param}",
+	"gtfield":       "The field '{field}' should be greater than the field {param}",
+	"gtefield":      "The field '{field}' should be greater than or equal the field {param}",
+	"ltfield":       "The field '{field}' should be less than the field {param}",
+	"ltefield":      "The field '{field}' should be less than or equal the field {param}",
+	"eqcsfield":     "The field '{field}' should be equal to the field {param}",
+	"necsfield":     "The field '{field}' should not be equal to the field {param}",
+	"gtcsfield":     "The field '{field}' should be greater than the field {param}",
+	"gtecsfield":    "The field '{field}' should be greater than or equal the field {param}",
+	"ltcsfield":     "The field '{field}' should be less than the field {param}",
+	"ltecsfield":    "The field '{field}' should be less than or equal the field {param}",
+	"fieldcontains": "The field '{field}' should contain the field {param}",
+	"fieldexcludes": "The field '{field}' should not contain the field {param}",
+
+	// strings
+	"alpha":           "The field '{field}' should contain only letters",
+	"alphanum":        "The field '{field}' should contain only letters and numbers",
+	"alphaunicode":    "The field '{field}' should contain only unicode letters",
+	"alphanumunicode": "The field '{field}' should contain only unicode letters and numbers",
+	"ascii":           "The field '{field}' should contain only ascii characters",
+	"printascii":      "The field '{field}' should contain only printable ascii characters",
+	"multibyte":       "The field '{field}' should contain multibyte characters",
+	"lowercase":       "The field '{field}' should be lowercase",
+	"uppercase":       "The field '{field}' should be uppercase",
+	"contains":        "The field '{field}' should contain the text '{param}'",
+	"containsany":     "The field '{field}' should contain at least one of the characters '{param}'",
+	"containsrune":    "The field '{field}' should contain the character '{param}'",
+	"excludes":        "The field '{field}' should not contain the text '{param}'",
+	"excludesall":     "The field '{field}' should not contain any of the characters '{param}'",
+	"excludesrune":    "The field '{field}' should not contain the character '{param}'",
+	"startswith":      "The field '{field}' should start with '{param}'",
+	"endswith":        "The field '{field}' should end with '{param}'",
+	"startsnotwith":   "The field '{field}' should not start with '{param}'",
+	"endsnotwith":     "The field '{field}' should not end with '{param}'",
+
+	// numbers and encodings
+	"boolean":      "The field '{field}' should be a valid boolean",
+	"numeric":      "The field '{field}' should be a valid numeric value",
+	"number":       "The field '{field}' should be a valid number",
+	"hexadecimal":  "The field '{field}' should be a valid hexadecimal",
+	"base32":       "The field '{field}' should be a valid base32 string",
+	"base64":       "The field '{field}' should be a valid base64 string",
+	"base64url":    "The field '{field}' should be a valid base64 url string",
+	"base64rawurl": "The field '{field}' should be a valid base64 raw url string",
+	"html":         "The field '{field}' should be a valid html",
+	"html_encoded": "The field '{field}' should be a valid html encoded string",
+	"url_encoded":  "The field '{field}' should be a valid url encoded string",
+	"json":         "The field '{field}' should be a valid json",
+	"jwt":          "The field '{field}' should be a valid jwt",
+	"datauri":      "The field '{field}' should be a valid data uri",
+
+	// colors
+	"iscolor":  "The field '{field}' should be a valid color",
+	"hexcolor": "The field '{field}' should be a valid hex color",
+	"rgb":      "The field '{field}' should be a valid rgb color",
+	"rgba":     "The field '{field}' should be a valid rgba color",
+	"hsl":      "The field '{field}' should be a valid hsl color",
+	"hsla":     "The field '{field}' should be a valid hsla color",
+
+	// contact and web
+	"email":             "The field '{field}' should be a valid email",
+	"e164":              "The field '{field}' should be a valid phone number in the E.164 format",
+	"url":               "The field '{field}' should be a valid url",
+	"http_url":          "The field '{field}' should be a valid http url",
+	"uri":               "The field '{field}' should be a valid uri",
+	"urn_rfc2141":       "The field '{field}' should be a valid urn",
+	"hostname":          "The field '{field}' should be a valid hostname",
+	"hostname_rfc1123":  "The field '{field}' should be a valid hostname",
+	"hostname_port":     "The field '{field}' should be a valid host and port",
+	"fqdn":              "The field '{field}' should be a valid fully qualified domain name",
+	"dns_rfc1035_label": "The field '{field}' should be a valid dns label",
+
+	// files
+	"file":     "The field '{field}' should be an existing file",
+	"filepath": "The field '{field}' should be a valid file path",
+	"dir":      "The field '{field}' should be an existing directory",
+	"dirpath":  "The field '{field}' should be a valid directory path",
+	"image":    "The field '{field}' should be a valid image",
+
+	// identifiers
+	"uuid":                      "The format of '{field}' should be uuid",
+	"uuid3":                     "The format of '{field}' should be uuid3",
+	"uuid4":                     "The format of '{field}' should be uuid4",
+	"uuid5":                     "The format of '{field}' should be uuid5",
+	"uuid_rfc4122":              "The format of '{field}' should be uuid",
+	"uuid3_rfc4122":             "The format of '{field}' should be uuid3",
+	"uuid4_rfc4122":             "The format of '{field}' should be uuid4",
+	"uuid5_rfc4122":             "The format of '{field}' should be uuid5",
+	"ulid":                      "The format of '{field}' should be ulid",
+	"isbn":                      "The field '{field}' should be a valid isbn",
+	"isbn10":                    "The field '{field}' should be a valid isbn10",
+	"isbn13":                    "The field '{field}' should be a valid isbn13",
+	"issn":                      "The field '{field}' should be a valid issn",
+	"ssn":                       "The field '{field}' should be a valid ssn",
+	"semver":                    "The field '{field}' should be a valid semantic version",
+	"cve":                       "The field '{field}' should be a valid cve identifier",
+	"mongodb":                   "The field '{field}' should be a valid mongodb object id",
+	"mongodb_connection_string": "The field '{field}' should be a valid mongodb connection string",
+	"spicedb":                   "The field '{field}' should be a valid spicedb identifier",
+	"cron":                      "The field '{field}' should be a valid cron expression",
+	"bcp47_language_tag":        "The field '{field}' should be a valid language tag",
+
+	// hashes
+	"md4":       "The field '{field}' should be a valid md4 hash",
+	"md5":       "The field '{field}' should be a valid md5 hash",
+	"sha256":    "The field '{field}' should be a valid sha256 hash",
+	"sha384":    "The field '{field}' should be a valid sha384 hash",
+	"sha512":    "The field '{field}' should be a valid sha512 hash",
+	"ripemd128": "The field '{field}' should be a valid ripemd128 hash",
+	"ripemd160": "The field '{field}' should be a valid ripemd160 hash",
+	"tiger128":  "The field '{field}' should be a valid tiger128 hash",
+	"tiger160":  "The field '{field}' should be a valid tiger160 hash",
+	"tiger192":  "The field '{field}' should be a valid tiger192 hash",
+
+	// crypto
+	"eth_addr":          "The field '{field}' should be a valid ethereum address",
+	"eth_addr_checksum": "The field '{field}' should be a valid ethereum address with checksum",
+	"btc_addr":          "The field '{field}' should be a valid bitcoin address",
+	"btc_addr_bech32":   "The field '{field}' should be a valid bech32 bitcoin address",
+
+	// geo
+	"latitude":  "The field '{field}' should be a valid latitude",
+	"longitude": "The field '{field}' should be a valid longitude",
+
+	// network
+	"ip":        "The field '{field}' should be a valid ip address",
+	"ipv4":      "The field '{field}' should be a valid ipv4 address",
+	"ipv6":      "The field '{field}' should be a valid ipv6 address",
+	"cidr":      "The field '{field}' should be a valid cidr notation",
+	"cidrv4":    "The field '{field}' should be a valid ipv4 cidr notation",
+	"cidrv6":    "The field '{field}' should be a valid ipv6 cidr notation",
+	"tcp_addr":  "The field '{field}' should be a valid tcp address",
+	"tcp4_addr": "The field '{field}' should be a valid ipv4 tcp address",
+	"tcp6_addr": "The field '{field}' should be a valid ipv6 tcp address",
+	"udp_addr":  "The field '{field}' should be a valid udp address",
+	"udp4_addr": "The field '{field}' should be a valid ipv4 udp address",
+	"udp6_addr": "The field '{field}' should be a valid ipv6 udp address",
+	"ip_addr":   "The field '{field}' should be a valid resolvable ip address",
+	"ip4_addr":  "The field '{field}' should be a valid resolvable ipv4 address",
+	"ip6_addr":  "The field '{field}' should be a valid resolvable ipv6 address",
+	"unix_addr": "The field '{field}' should be a valid unix address",
+	"mac":       "The field '{field}' should be a valid mac address",
+
+	// decimal numbers and money, see ParseDecimal
+	"decimal":       "The field '{field}' should be a valid decimal number",
+	"money":         "The field '{field}' should be a valid amount of money",
+	"decimal_scale": "The field '{field}' should have at most {param} decimal places",
+	"decimal_min":   "The field '{field}' should be at least {param}",
+	"decimal_max":   "The field '{field}' should be at most {param}",
+
+	// dates
+	"date":         "The field '{field}' should be a valid date in the format YYYY-MM-DD",
+	"datetime_api": "The field '{field}' should be a valid date and time in the format YYYY-MM-DDThh:mm:ssZ",
+	"past":         "The field '{field}' should not be a future date",
+	"future":       "The field '{field}' should not be a past date",
+	"min_age":      "The field '{field}' should be the birth date of someone at least {param} years old",
+	"max_age":      "The field '{field}' should be the birth date of someone at most {param} years old",
+	"within_days":  "The field '{field}' should be a date within {param} days of today",
+	"datetime":     "The field '{field}' should be a valid date in the format {param}",
+	"timezone":     "The field '{field}' should be a valid timezone",
+
+	// countries, currencies and banking
+	"country_code":                  "The field '{field}' should be a valid country code",
+	"eu_country_code":               "The field '{field}' should be a valid european union country code",
+	"iso3166_1_alpha2":              "The field '{field}' should be a valid country code",
+	"iso3166_1_alpha2_eu":           "The field '{field}' should be a valid european union country code",
+	"iso3166_1_alpha3":              "The field '{field}' should be a valid country code",
+	"iso3166_1_alpha3_eu":           "The field '{field}' should be a valid european union country code",
+	"iso3166_1_alpha_numeric":       "The field '{field}' should be a valid numeric country code",
+	"iso3166_1_alpha_numeric_eu":    "The field '{field}' should be a valid numeric european union country code",
+	"iso3166_2":                     "The field '{field}' should be a valid country subdivision code",
+	"iso4217":                       "The field '{field}' should be a valid currency code",
+	"iso4217_numeric":               "The field '{field}' should be a valid numeric currency code",
+	"postcode_iso3166_alpha2":       "The field '{field}' should be a valid postcode of the country {param}",
+	"postcode_iso3166_alpha2_field": "The field '{field}' should be a valid postcode of the country in the field {param}",
+	"bic":                           "The field '{field}' should be a valid bic code",
+	"credit_card":                   "The field '{field}' should be a valid credit card number",
+	"luhn_checksum":                 "The field '{field}' should have a valid luhn checksum",
+}
@@ -0,0 +1,262 @@
+package validator
+
+// messagesPTBR is the built-in brazilian portuguese catalog
+var messagesPTBR = map[string]string{
+	fallbackTag: "O campo '{field}' é inválido.",
+
+	// custom tags
+	"required_trim":  "O campo '{field}' é obrigatório",
+	"cpf":            "O campo '{field}' deve ser um cpf válido",
+	"cnpj":           "O campo '{field}' deve ser um cnpj válido",
+	"cep":            "O campo '{field}' deve ser um cep válido",
+	"cnh":            "O campo '{field}' deve ser uma cnh válida",
+	"renavam":        "O campo '{field}' deve ser um renavam válido",
+	"pis":            "O campo '{field}' deve ser um pis/pasep/nit válido",
+	"titulo_eleitor": "O campo '{field}' deve ser um título de eleitor válido",
+	"plate":          "O campo '{field}' deve ser uma placa de veículo válida",
+	"ie":             "O campo '{field}' deve ser uma inscrição estadual válida",
+	"cns":            "O campo '{field}' deve ser um cartão nacional de saúde válido",
+	"pix_key":        "O campo '{field}' deve ser uma chave pix válida",
+	"evp":            "O campo '{field}' deve ser uma chave pix aleatória válida",
+	"bank_agency":    "O campo '{field}' deve ser uma agência bancária válida",
+	"bank_account":   "O campo '{field}' deve ser uma conta bancária válida",
+	"boleto":         "O campo '{field}' deve ser um boleto válido",
+	"phone":          "O campo '{field}' deve ser um telefone válido",
+	"required_when":  "O campo '{field}' é obrigatório quando {param}",
+	"excluded_when":  "O campo '{field}' não deve ser informado quando {param}",
+
+	// password rules, see PasswordPolicy
+	"password":            "O campo '{field}' deve ser uma senha forte",
+	"password_min_length": "O campo '{field}' deve ter no mínimo {param} caracteres",
+	"password_max_length": "O campo '{field}' deve ter no máximo {param} caracteres",
+	"password_upper":      "O campo '{field}' deve ter uma letra maiúscula",
+	"password_lower":      "O campo '{field}' deve ter uma letra minúscula",
+	"password_digit":      "O campo '{field}' deve ter um número",
+	"password_symbol":     "O campo '{field}' deve ter um símbolo",
+	"password_repeated":   "O campo '{field}' não deve repetir um caractere mais de {param} vezes seguidas",
+	"password_common":     "O campo '{field}' é uma senha muito comum",
+	"password_user_info":  "O campo '{field}' não deve conter o email ou o nome",
+
+	// uploaded files
+	"max_size":       "O arquivo '{field}' deve ter no máximo {param}",
+	"mime":           "O arquivo '{field}' deve ser do tipo {param}",
+	"ext":            "O arquivo '{field}' deve ter a extensão {param}",
+	"min_dimensions": "A imagem '{field}' deve ter no mínimo {param} pixels",
+	"max_dimensions": "A imagem '{field}' deve ter no máximo {param} pixels",
+
+	// binding, see Bind
+	"type":          "O campo '{field}' deve ser do tipo {param}",
+	"unknown_field": "O campo '{field}' não é permitido",
+
+	// required and excluded
+	"required":             "O campo '{field}' é obrigatório",
+	"required_if":          "O campo '{field}' é obrigatório quando {param}",
+	"required_unless":      "O campo '{field}' é obrigatório, exceto quando {param}",
+	"required_with":        "O campo '{field}' é obrigatório quando {param} estiver presente",
+	"required_with_all":    "O campo '{field}' é obrigatório quando todos os campos {param} estiverem presentes",
+	"required_without":     "O campo '{field}' é obrigatório quando {param} não estiver presente",
+	"required_without_all": "O campo '{field}' é obrigatório quando nenhum dos campos {param} estiver presente",
+	"excluded_if":          "O campo '{field}' não deve ser informado quando {param}",
+	"excluded_unless":      "O campo '{field}' não deve ser informado, exceto quando {param}",
+	"excluded_with":        "O campo '{field}' não deve ser informado quando {param} estiver presente",
+	"excluded_with_all":    "O campo '{field}' não deve ser informado quando todos os campos {param} estiverem presentes",
+	"excluded_without":     "O campo '{field}' não deve ser informado quando {param} não estiver presente",
+	"excluded_without_all": "O campo '{field}' não deve ser informado quando nenhum dos campos {param} estiver presente",
+	"isdefault":            "O campo '{field}' deve estar vazio",
+
+	// comparisons
+	"len":            "O campo '{field}' deve ter o tamanho ou valor: {param}",
+	"min":            "O campo '{field}' deve ter o tamanho ou valor mínimo: {param}",
+	"max":            "O campo '{field}' deve ter o tamanho ou valor máximo: {param}",
+	"eq":             "O valor de '{field}' deve ser igual a {param}",
+	"eq_ignore_case": "O valor de '{field}' deve ser igual a {param}, ignorando maiúsculas e minúsculas",
+	"ne":             "O valor de '{field}' deve ser diferente de {param}",
+	"ne_ignore_case": "O valor de '{field}' deve ser diferente de {param}, ignorando maiúsculas e minúsculas",
+	"lt":             "O campo '{field}' deve ser menor que {param}",
+	"lte":            "O campo '{field}' deve ser menor ou igual a {param}",
+	"gt":             "O campo '{field}' deve ser maior que {param}",
+	"gte":            "O campo '{field}' deve ser maior ou igual a {param}",
+	"oneof":          "O campo '{field}' deve ser um dos valores: {param}",
+	"unique":         "O campo '{field}' deve conter valores únicos",
+
+	// comparisons with other fields
+	"eqfield":       "O campo '{field}' deve ser igual ao campo {param}",
+	"nefield":       "O campo '{field}' deve ser diferente do campo {param}",
+	"gtfield":       "O campo '{field}' deve ser maior que o campo {param}",
+	"gtefield":      "O campo '{field}' deve ser maior ou igual ao campo {param}",
+	"ltfield":       "O campo '{field}' deve ser menor que o campo {param}",
+	"ltefield":      "O campo '{field}' deve ser menor ou igual ao campo {param}",
+	"eqcsfield":     "O campo '{field}' deve ser igual ao campo {param}",
+	"necsfield":     "O campo '{field}' deve ser diferente do campo {param}",
+	"gtcsfield":     "O campo '{field}' deve ser maior que o campo {param}",
+	"gtecsfield":    "O campo '{field}' deve ser maior ou igual ao campo {param}",
+	"ltcsfield":     "O campo '{field}' deve ser menor que o campo {param}",
+	"ltecsfield":    "O campo '{field}' deve ser menor ou igual ao campo {param}",
+	"fieldcontains": "O campo '{field}' deve conter o campo {param}",
+	"fieldexcludes": "O campo '{field}' não deve conter o campo {param}",
+
+	// strings
+	"alpha":           "O campo '{field}' deve conter apenas letras",
+	"alphanum":        "O campo '{field}' deve conter apenas letras e números",
+	"alphaunicode":    "O campo '{field}' deve conter apenas letras unicode",
+	"alphanumunicode": "O campo '{field}' deve conter apenas letras unicode e números",
+	"ascii":           "O campo '{field}' deve conter apenas caracteres ascii",
+	"printascii":      "O campo '{field}' deve conter apenas caracteres ascii imprimíveis",
+	"multibyte":       "O campo '{field}' deve conter caracteres multibyte",
+	"lowercase":       "O campo '{field}' deve estar em letras minúsculas",
+	"uppercase":       "O campo '{field}' deve estar em letras maiúsculas",
+	"contains":        "O campo '{field}' deve conter o texto '{param}'",
+	"containsany":     "O campo '{field}' deve conter ao menos um dos caracteres '{param}'",
+	"containsrune":    "O campo '{field}' deve conter o caractere '{param}'",
+	"excludes":        "O campo '{field}' não deve conter o texto '{param}'",
+	"excludesall":     "O campo '{field}' não deve conter nenhum dos caracteres '{param}'",
+	"excludesrune":    "O campo '{field}' não deve conter o caractere '{param}'",
+	"startswith":      "O campo '{field}' deve começar com '{param}'",
+	"endswith":        "O campo '{field}' deve terminar com '{param}'",
+	"startsnotwith":   "O campo '{field}' não deve começar com '{param}'",
+	"endsnotwith":     "O campo '{field}' não deve terminar com '{param}'",
+
+	// numbers and encodings
+	"boolean":      "O campo '{field}' deve ser um booleano válido",
+	"numeric":      "O campo '{field}' deve ser um valor numérico válido",
+	"number":       "O campo '{field}' deve ser um número válido",
+	"hexadecimal":  "O campo '{field}' deve ser um hexadecimal válido",
+	"base32":       "O campo '{field}' deve ser um texto base32 válido",
+	"base64":       "O campo '{field}' deve ser um texto base64 válido",
+	"base64url":    "O campo '{field}' deve ser um texto base64 url válido",
+	"base64rawurl": "O campo '{field}' deve ser um texto base64 raw url válido",
+	"html":         "O campo '{field}' deve ser um html válido",
+	"html_encoded": "O campo '{field}' deve ser um texto html codificado válido",
+	"url_encoded":  "O campo '{field}' deve ser um texto url codificado válido",
+	"json":         "O campo '{field}' deve ser um json válido",
+	"jwt":          "O campo '{field}' deve ser um jwt válido",
+	"datauri":      "O campo '{field}' deve ser um data uri válido",
+
+	// colors
+	"iscolor":  "O campo '{field}' deve ser uma cor válida",
+	"hexcolor": "O campo '{field}' deve ser uma cor hexadecimal válida",
+	"rgb":      "O campo '{field}' deve ser uma cor rgb válida",
+	"rgba":     "O campo '{field}' deve ser uma cor rgba válida",
+	"hsl":      "O campo '{field}' deve ser uma cor hsl válida",
+	"hsla":     "O campo '{field}' deve ser uma cor hsla válida",
+
+	// contact and web
+	"email":             "O campo '{field}' deve ser um e-mail válido",
+	"e164":              "O campo '{field}' deve ser um telefone válido no formato E.164",
+	"url":               "O campo '{field}' deve ser uma url válida",
+	"http_url":          "O campo '{field}' deve ser uma url http válida",
+	"uri":               "O campo '{field}' deve ser uma uri válida",
+	"urn_rfc2141":       "O campo '{field}' deve ser uma urn válida",
+	"hostname":          "O campo '{field}' deve ser um hostname válido",
+	"hostname_rfc1123":  "O campo '{field}' deve ser um hostname válido",
+	"hostname_port":     "O campo '{field}' deve ser um host e porta válidos",
+	"fqdn":              "O campo '{field}' deve ser um nome de domínio completo válido",
+	"dns_rfc1035_label": "O campo '{field}' deve ser um rótulo dns válido",
+
+	// files
+	"file":     "O campo '{field}' deve ser um arquivo existente",
+	"filepath": "O campo '{field}' deve ser um caminho de arquivo válido",
+	"dir":      "O campo '{field}' deve ser um diretório existente",
+	"dirpath":  "O campo '{field}' deve ser um caminho de diretório válido",
+	"image":    "O campo '{field}' deve ser uma imagem válida",
+
+	// identifiers
+	"uuid":                      "O formato de '{field}' deve ser uuid",
+	"uuid3":                     "O formato de '{field}' deve ser uuid3",
+	"uuid4":                     "O formato de '{field}' deve ser uuid4",
+	"uuid5":                     "O formato de '{field}' deve ser uuid5",
+	"uuid_rfc4122":              "O formato de '{field}' deve ser uuid",
+	"uuid3_rfc4122":             "O formato de '{field}' deve ser uuid3",
+	"uuid4_rfc4122":             "O formato de '{field}' deve ser uuid4",
+	"uuid5_rfc4122":             "O formato de '{field}' deve ser uuid5",
+	"ulid":                      "O formato de '{field}' deve ser ulid",
+	"isbn":                      "O campo '{field}' deve ser um isbn válido",
+	"isbn10":                    "O campo '{field}' deve ser um isbn10 válido",
+	"isbn13":                    "O campo '{field}' deve ser um isbn13 válido",
+	"issn":                      "O campo '{field}' deve ser um issn válido",
+	"ssn":                       "O campo '{field}' deve ser um ssn válido",
+	"semver":                    "O campo '{field}' deve ser uma versão semântica válida",
+	"cve":                       "O campo '{field}' deve ser um identificador cve válido",
+	"mongodb":                   "O campo '{field}' deve ser um object id do mongodb válido",
+	"mongodb_connection_string": "O campo '{field}' deve ser uma string de conexão do mongodb válida",
+	"spicedb":                   "O campo '{field}' deve ser um identificador spicedb válido",
+	"cron":                      "O campo '{field}' deve ser uma expressão cron válida",
+	"bcp47_language_tag":        "O campo '{field}' deve ser uma tag de idioma válida",
+
+	// hashes
+	"md4":       "O campo '{field}' deve ser um hash md4 válido",
+	"md5":       "O campo '{field}' deve ser um hash md5 válido",
+	"sha256":    "O campo '{field}' deve ser um hash sha256 válido",
+	"sha384":    "O campo '{field}' deve ser um hash sha384 válido",
+	"sha512":    "O campo '{field}' deve ser um hash sha512 válido",
+	"ripemd128": "O campo '{field}' deve ser um hash ripemd128 válido",
+	"ripemd160": "O campo '{field}' deve ser um hash ripemd160 válido",
+	"tiger128":  "O campo '{field}' deve ser um hash tiger128 válido",
+	"tiger160":  "O campo '{field}' deve ser um hash tiger160 válido",
+	"tiger192":  "O campo '{field}' deve ser um hash tiger192 válido",
+
+	// crypto
+	"eth_addr":          "O campo '{field}' deve ser um endereço ethereum válido",
+	"eth_addr_checksum": "O campo '{field}' deve ser um endereço ethereum com checksum válido",
+	"btc_addr":          "O campo '{field}' deve ser um endereço bitcoin válido",
+	"btc_addr_bech32":   "O campo '{field}' deve ser um endereço bitcoin bech32 válido",
+
+	// geo
+	"latitude":  "O campo '{field}' deve ser uma latitude válida",
+	"longitude": "O campo '{field}' deve ser uma longitude válida",
+
+	// network
+	"ip":        "O campo '{field}' deve ser um endereço ip válido",
+	"ipv4":      "O campo '{field}' deve ser um endereço ipv4 válido",
+	"ipv6":      "O campo '{field}' deve ser um endereço ipv6 válido",
+	"cidr":      "O campo '{field}' deve ser uma notação cidr válida",
+	"cidrv4":    "O campo '{field}' deve ser uma notação cidr ipv4 válida",
+	"cidrv6":    "O campo '{field}' deve ser uma notação cidr ipv6 válida",
+	"tcp_addr":  "O campo '{field}' deve ser um endereço tcp válido",
+	"tcp4_addr": "O campo '{field}' deve ser um endereço tcp ipv4 válido",
+	"tcp6_addr": "O campo '{field}' deve ser um endereço tcp ipv6 válido",
+	"udp_addr":  "O campo '{field}' deve ser um endereço udp válido",
+	"udp4_addr": "O campo '{field}' deve ser um endereço udp ipv4 válido",
+	"udp6_addr": "O campo '{field}' deve ser um endereço udp ipv6 válido",
+	"ip_addr":   "O campo '{field}' deve ser um endereço ip resolvível",
+	"ip4_addr":  "O campo '{field}' deve ser um endereço ipv4 resolvível",
+	"ip6_addr":  "O campo '{field}' deve ser um endereço ipv6 resolvível",
+	"unix_addr": "O campo '{field}' deve ser um endereço unix válido",
+	"mac":       "O campo '{field}' deve ser um endereço mac válido",
+
+	// decimal numbers and money, see ParseDecimal
+	"decimal":       "O campo '{field}' deve ser um número decimal válido",
+	"money":         "O campo '{field}' deve ser um valor monetário válido",
+	"decimal_scale": "O campo '{field}' deve ter no máximo {param} casas decimais",
+	"decimal_min":   "O campo '{field}' deve ser no mínimo {param}",
+	"decimal_max":   "O campo '{field}' deve ser no máximo {param}",
+
+	// dates
+	"date":         "O campo '{field}' deve ser uma data válida no formato AAAA-MM-DD",
+	"datetime_api": "O campo '{field}' deve ser uma data e hora válida no formato AAAA-MM-DDThh:mm:ssZ",
+	"past":         "O campo '{field}' não deve ser uma data futura",
+	"future":       "O campo '{field}' não deve ser uma data passada",
+	"min_age":      "O campo '{field}' deve ser a data de nascimento de alguém com pelo menos {param} anos",
+	"max_age":      "O campo '{field}' deve ser a data de nascimento de alguém com no máximo {param} anos",
+	"within_days":  "O campo '{field}' deve ser uma data de até {param} dias de hoje",
+	"datetime":     "O campo '{field}' deve ser uma data válida no formato {param}",
+	"timezone":     "O campo '{field}' deve ser um fuso horário válido",
+
+	// countries, currencies and banking
+	"country_code":                  "O campo '{field}' deve ser um código de país válido",
+	"eu_country_code":               "O campo '{field}' deve ser um código de país da união europeia válido",
+	"iso3166_1_alpha2":              "O campo '{field}' deve ser um código de país válido",
+	"iso3166_1_alpha2_eu":           "O campo '{field}' deve ser um código de país da união europeia válido",
+	"iso3166_1_alpha3":              "O campo '{field}' deve ser um código de país válido",
+	"iso3166_1_alpha3_eu":           "O campo '{field}' deve ser um código de país da união europeia válido",
+	"iso3166_1_alpha_numeric":       "O campo '{field}' deve ser um código numérico de país válido",
+	"iso3166_1_alpha_numeric_eu":    "O campo '{field}' deve ser um código numérico de país da união europeia válido",
+	"iso3166_2":                     "O campo '{field}' deve ser um código de subdivisão de país válido",
+	"iso4217":                       "O campo '{field}' deve ser um código de moeda válido",
+	"iso4217_numeric":               "O campo '{field}' deve ser um código numérico de moeda válido",
+	"postcode_iso3166_alpha2":       "O campo '{field}' deve ser um código postal válido do país {param}",
+	"postcode_iso3166_alpha2_field": "O campo '{field}' deve ser um código postal válido do país informado no campo {param}",
+	"bic":                           "O campo '{field}' deve ser um código bic válido",
+	"credit_card":                   "O campo '{field}' deve ser um número de cartão de crédito válido",
+	"luhn_checksum":                 "O campo '{field}' deve ter um dígito verificador luhn válido",
+}
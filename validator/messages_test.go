@@ -0,0 +1,93 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMessages_Message(t *testing.T) {
+	m := NewMessages()
+	m.Register(LocalePTBR, "parking_spot", "O campo '{field}' deve ser uma vaga válida")
+
+	tests := []struct {
+		name     string
+		locale   string
+		tag      string
+		param    string
+		expected string
+	}{
+		{
+			name:     "should use the default locale when no locale is given",
+			tag:      "required",
+			expected: "The field 'name' is required",
+		},
+		{
+			name:     "should use the pt-BR catalog",
+			locale:   LocalePTBR,
+			tag:      "oneof",
+			param:    "PF PJ",
+			expected: "O campo 'name' deve ser um dos valores: PF PJ",
+		},
+		{
+			name:     "should match the locale ignoring case and separator",
+			locale:   "pt_br",
+			tag:      "required",
+			expected: "O campo 'name' é obrigatório",
+		},
+		{
+			name:     "should match the base language",
+			locale:   "pt-PT",
+			tag:      "required",
+			expected: "O campo 'name' é obrigatório",
+		},
+		{
+			name:     "should use the default locale for unknown locales",
+			locale:   "fr",
+			tag:      "url",
+			expected: "The field 'name' should be a valid url",
+		},
+		{
+			name:     "should use the generic message of the locale for unknown tags",
+			locale:   LocalePTBR,
+			tag:      "unknown",
+			expected: "O campo 'name' é inválido.",
+		},
+		{
+			name:     "should use a registered template",
+			locale:   LocalePTBR,
+			tag:      "parking_spot",
+			expected: "O campo 'name' deve ser uma vaga válida",
+		},
+		{
+			name:     "should fall back to the generic message of the default locale",
+			tag:      "parking_spot",
+			expected: "The field 'name' is invalid.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, m.Message(tt.locale, tt.tag, "name", tt.param))
+		})
+	}
+}
+
+func Test_validatorImpl_ValidateStruct_Locale(t *testing.T) {
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	dataSet := struct {
+		DocumentNumber string `json:"document_number" validate:"cpf"`
+	}{
+		DocumentNumber: "12345678910",
+	}
+
+	err = v.ValidateStruct(ContextWithLocale(context.Background(), LocalePTBR), dataSet)
+	assert.Contains(t, err.Error(), "O campo 'document_number' deve ser um cpf válido")
+
+	v.RegisterMessage(LocalePTBR, "cpf", "CPF inválido em '{field}'")
+	err = v.ValidateStruct(ContextWithLocale(context.Background(), LocalePTBR), dataSet)
+	assert.Contains(t, err.Error(), "CPF inválido em 'document_number'")
+}
@@ -0,0 +1,252 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: validator/validator.go
+
+// Package validator is a generated GoMock package.
+package validator
+
+import (
+	context "context"
+	reflect "reflect"
+
+	v10 "github.com/go-playground/validator/v10"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockValidator is a mock of Validator interface.
+type MockValidator struct {
+	ctrl     *gomock.Controller
+	recorder *MockValidatorMockRecorder
+}
+
+// MockValidatorMockRecorder is the mock recorder for MockValidator.
+type MockValidatorMockRecorder struct {
+	mock *MockValidator
+}
+
+// NewMockValidator creates a new mock instance.
+func NewMockValidator(ctrl *gomock.Controller) *MockValidator {
+	mock := &MockValidator{ctrl: ctrl}
+	mock.recorder = &MockValidatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockValidator) EXPECT() *MockValidatorMockRecorder {
+	return m.recorder
+}
+
+// FieldSchemaConstraints mocks base method.
+func (m *MockValidator) FieldSchemaConstraints(field reflect.StructField) SchemaConstraints {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FieldSchemaConstraints", field)
+	ret0, _ := ret[0].(SchemaConstraints)
+	return ret0
+}
+
+// FieldSchemaConstraints indicates an expected call of FieldSchemaConstraints.
+func (mr *MockValidatorMockRecorder) FieldSchemaConstraints(field interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FieldSchemaConstraints", reflect.TypeOf((*MockValidator)(nil).FieldSchemaConstraints), field)
+}
+
+// Normalize mocks base method.
+func (m *MockValidator) Normalize(ctx context.Context, ptr any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Normalize", ctx, ptr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Normalize indicates an expected call of Normalize.
+func (mr *MockValidatorMockRecorder) Normalize(ctx, ptr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Normalize", reflect.TypeOf((*MockValidator)(nil).Normalize), ctx, ptr)
+}
+
+// RegisterAlias mocks base method.
+func (m *MockValidator) RegisterAlias(alias, tags string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterAlias", alias, tags)
+}
+
+// RegisterAlias indicates an expected call of RegisterAlias.
+func (mr *MockValidatorMockRecorder) RegisterAlias(alias, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterAlias", reflect.TypeOf((*MockValidator)(nil).RegisterAlias), alias, tags)
+}
+
+// RegisterMessage mocks base method.
+func (m *MockValidator) RegisterMessage(locale, tag, template string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterMessage", locale, tag, template)
+}
+
+// RegisterMessage indicates an expected call of RegisterMessage.
+func (mr *MockValidatorMockRecorder) RegisterMessage(locale, tag, template interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterMessage", reflect.TypeOf((*MockValidator)(nil).RegisterMessage), locale, tag, template)
+}
+
+// RegisterValidation mocks base method.
+func (m *MockValidator) RegisterValidation(tag string, fn v10.Func) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterValidation", tag, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterValidation indicates an expected call of RegisterValidation.
+func (mr *MockValidatorMockRecorder) RegisterValidation(tag, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterValidation", reflect.TypeOf((*MockValidator)(nil).RegisterValidation), tag, fn)
+}
+
+// RegisterValidationCtx mocks base method.
+func (m *MockValidator) RegisterValidationCtx(tag string, fn ValidationFuncCtx) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterValidationCtx", tag, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterValidationCtx indicates an expected call of RegisterValidationCtx.
+func (mr *MockValidatorMockRecorder) RegisterValidationCtx(tag, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterValidationCtx", reflect.TypeOf((*MockValidator)(nil).RegisterValidationCtx), tag, fn)
+}
+
+// StructExcept mocks base method.
+func (m *MockValidator) StructExcept(current any, fields ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{current}
+	for _, a := range fields {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StructExcept", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StructExcept indicates an expected call of StructExcept.
+func (mr *MockValidatorMockRecorder) StructExcept(current interface{}, fields ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{current}, fields...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StructExcept", reflect.TypeOf((*MockValidator)(nil).StructExcept), varargs...)
+}
+
+// StructFiltered mocks base method.
+func (m *MockValidator) StructFiltered(current any, filter v10.FilterFunc) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StructFiltered", current, filter)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StructFiltered indicates an expected call of StructFiltered.
+func (mr *MockValidatorMockRecorder) StructFiltered(current, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StructFiltered", reflect.TypeOf((*MockValidator)(nil).StructFiltered), current, filter)
+}
+
+// StructPartial mocks base method.
+func (m *MockValidator) StructPartial(current any, fields ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{current}
+	for _, a := range fields {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StructPartial", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StructPartial indicates an expected call of StructPartial.
+func (mr *MockValidatorMockRecorder) StructPartial(current interface{}, fields ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{current}, fields...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StructPartial", reflect.TypeOf((*MockValidator)(nil).StructPartial), varargs...)
+}
+
+// StructSchemaConstraints mocks base method.
+func (m *MockValidator) StructSchemaConstraints(dataSet any) map[string]SchemaConstraints {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StructSchemaConstraints", dataSet)
+	ret0, _ := ret[0].(map[string]SchemaConstraints)
+	return ret0
+}
+
+// StructSchemaConstraints indicates an expected call of StructSchemaConstraints.
+func (mr *MockValidatorMockRecorder) StructSchemaConstraints(dataSet interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StructSchemaConstraints", reflect.TypeOf((*MockValidator)(nil).StructSchemaConstraints), dataSet)
+}
+
+// ValidateMap mocks base method.
+func (m *MockValidator) ValidateMap(ctx context.Context, data, rules map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateMap", ctx, data, rules)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateMap indicates an expected call of ValidateMap.
+func (mr *MockValidatorMockRecorder) ValidateMap(ctx, data, rules interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateMap", reflect.TypeOf((*MockValidator)(nil).ValidateMap), ctx, data, rules)
+}
+
+// ValidatePatch mocks base method.
+func (m *MockValidator) ValidatePatch(ctx context.Context, raw []byte, dst any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatePatch", ctx, raw, dst)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidatePatch indicates an expected call of ValidatePatch.
+func (mr *MockValidatorMockRecorder) ValidatePatch(ctx, raw, dst interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatePatch", reflect.TypeOf((*MockValidator)(nil).ValidatePatch), ctx, raw, dst)
+}
+
+// ValidateSlice mocks base method.
+func (m *MockValidator) ValidateSlice(ctx context.Context, items any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateSlice", ctx, items)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateSlice indicates an expected call of ValidateSlice.
+func (mr *MockValidatorMockRecorder) ValidateSlice(ctx, items interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateSlice", reflect.TypeOf((*MockValidator)(nil).ValidateSlice), ctx, items)
+}
+
+// ValidateStruct mocks base method.
+func (m *MockValidator) ValidateStruct(ctx context.Context, dataSet any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateStruct", ctx, dataSet)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateStruct indicates an expected call of ValidateStruct.
+func (mr *MockValidatorMockRecorder) ValidateStruct(ctx, dataSet interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateStruct", reflect.TypeOf((*MockValidator)(nil).ValidateStruct), ctx, dataSet)
+}
+
+// Var mocks base method.
+func (m *MockValidator) Var(field any, tag string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Var", field, tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Var indicates an expected call of Var.
+func (mr *MockValidatorMockRecorder) Var(field, tag interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Var", reflect.TypeOf((*MockValidator)(nil).Var), field, tag)
+}
@@ -0,0 +1,164 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/go_utils/validator/brdocs"
+)
+
+// normalizeTags are the struct tags read by Normalize, mod is accepted as an alias of normalize
+var normalizeTags = []string{"normalize", "mod"}
+
+// normalizers are the modifiers that can be used in the normalize tag
+var normalizers = map[string]func(string) string{
+	"trim":            strings.TrimSpace,
+	"lower":           strings.ToLower,
+	"upper":           strings.ToUpper,
+	"digits_only":     digitsOnly,
+	"unmask_cpf":      brdocs.Unformat,
+	"collapse_spaces": collapseSpaces,
+	"title":           titleCase,
+	"phone":           normalizePhone,
+}
+
+// normalizeTagCache caches the parsed modifiers of each tag
+var normalizeTagCache sync.Map
+
+func (v *validatorImpl) Normalize(ctx context.Context, ptr any) error {
+	value := reflect.ValueOf(ptr)
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		return resterrors.NewInternalServerError(fmt.Sprintf("Invalid argument passed to normalize: expected a non nil pointer, got %T", ptr))
+	}
+
+	return normalizeValue(value.Elem(), nil)
+}
+
+// normalizeValue applies the modifiers to the string values and walks into structs, slices, arrays and maps
+func normalizeValue(value reflect.Value, modifiers []func(string) string) error {
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+		return normalizeValue(value.Elem(), modifiers)
+
+	case reflect.String:
+		if len(modifiers) > 0 && value.CanSet() {
+			value.SetString(applyNormalizers(value.String(), modifiers))
+		}
+
+	case reflect.Struct:
+		typ := value.Type()
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+
+			fieldModifiers, err := parseNormalizeTag(field)
+			if err != nil {
+				return err
+			}
+
+			err = normalizeValue(value.Field(i), fieldModifiers)
+			if err != nil {
+				return err
+			}
+		}
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			err := normalizeValue(value.Index(i), modifiers)
+			if err != nil {
+				return err
+			}
+		}
+
+	case reflect.Map:
+		// map values are not addressable, so they are normalized in a copy and set back
+		iter := value.MapRange()
+		for iter.Next() {
+			elem := reflect.New(iter.Value().Type()).Elem()
+			elem.Set(iter.Value())
+
+			err := normalizeValue(elem, modifiers)
+			if err != nil {
+				return err
+			}
+			value.SetMapIndex(iter.Key(), elem)
+		}
+	}
+
+	return nil
+}
+
+// parseNormalizeTag returns the modifiers of the normalize tag of the field, e.g. normalize:"trim,lower"
+func parseNormalizeTag(field reflect.StructField) ([]func(string) string, error) {
+	var tag string
+	for _, key := range normalizeTags {
+		if tag = field.Tag.Get(key); tag != "" {
+			break
+		}
+	}
+
+	if tag == "" {
+		return nil, nil
+	}
+
+	if cached, ok := normalizeTagCache.Load(tag); ok {
+		return cached.([]func(string) string), nil
+	}
+
+	var modifiers []func(string) string
+	for _, name := range strings.Split(tag, ",") {
+		name = strings.TrimSpace(name)
+		modifier, ok := normalizers[name]
+		if !ok {
+			return nil, resterrors.NewInternalServerError(fmt.Sprintf("Unknown normalize modifier '%s' in the field %s", name, field.Name))
+		}
+		modifiers = append(modifiers, modifier)
+	}
+
+	normalizeTagCache.Store(tag, modifiers)
+	return modifiers, nil
+}
+
+func applyNormalizers(value string, modifiers []func(string) string) string {
+	for _, modifier := range modifiers {
+		value = modifier(value)
+	}
+	return value
+}
+
+func digitsOnly(value string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, value)
+}
+
+// collapseSpaces trims the value and replaces each sequence of white spaces by a single space
+func collapseSpaces(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
+
+// titleCase writes the first letter of each word in upper case and the others in lower case
+func titleCase(value string) string {
+	runes := []rune(value)
+	for i, r := range runes {
+		if i > 0 && (unicode.IsLetter(runes[i-1]) || runes[i-1] == '\'') {
+			runes[i] = unicode.ToLower(r)
+		} else {
+			runes[i] = unicode.ToTitle(r)
+		}
+	}
+	return string(runes)
+}
@@ -0,0 +1,103 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validatorImpl_Normalize(t *testing.T) {
+	ctx := context.Background()
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	type address struct {
+		City string `json:"city" normalize:"collapse_spaces,title"`
+	}
+	type person struct {
+		Name       string            `json:"name" normalize:"trim,collapse_spaces,title"`
+		Email      string            `json:"email" mod:"trim,lower"`
+		CPF        string            `json:"cpf" normalize:"unmask_cpf"`
+		Phone      *string           `json:"phone" normalize:"digits_only"`
+		State      string            `json:"state" normalize:"trim,upper"`
+		Tags       []string          `json:"tags" normalize:"trim,lower"`
+		Labels     map[string]string `json:"labels" normalize:"trim"`
+		Address    address           `json:"address"`
+		Addresses  []*address        `json:"addresses"`
+		Untouched  string            `json:"untouched"`
+		unexported string
+	}
+
+	phone := "(11) 91234-5678"
+	p := person{
+		Name:       "  joão   DA silva d'ávila ",
+		Email:      " John@Example.COM ",
+		CPF:        "123.456.789-09",
+		Phone:      &phone,
+		State:      " sp",
+		Tags:       []string{" GO ", "Lang"},
+		Labels:     map[string]string{"team": " core "},
+		Address:    address{City: "são   paulo"},
+		Addresses:  []*address{{City: "RIO DE JANEIRO"}, nil},
+		Untouched:  " keep ",
+		unexported: " keep ",
+	}
+
+	err = v.Normalize(ctx, &p)
+	assert.NoError(t, err)
+	assert.Equal(t, "João Da Silva D'ávila", p.Name)
+	assert.Equal(t, "john@example.com", p.Email)
+	assert.Equal(t, "12345678909", p.CPF)
+	assert.Equal(t, "11912345678", *p.Phone)
+	assert.Equal(t, "SP", p.State)
+	assert.Equal(t, []string{"go", "lang"}, p.Tags)
+	assert.Equal(t, map[string]string{"team": "core"}, p.Labels)
+	assert.Equal(t, "São Paulo", p.Address.City)
+	assert.Equal(t, "Rio De Janeiro", p.Addresses[0].City)
+	assert.Equal(t, " keep ", p.Untouched)
+	assert.Equal(t, " keep ", p.unexported)
+}
+
+func Test_validatorImpl_Normalize_Errors(t *testing.T) {
+	ctx := context.Background()
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	type person struct {
+		Name string `normalize:"trim,reverse"`
+	}
+
+	err = v.Normalize(ctx, person{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "expected a non nil pointer")
+
+	err = v.Normalize(ctx, (*person)(nil))
+	assert.Error(t, err)
+
+	err = v.Normalize(ctx, &person{})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Unknown normalize modifier 'reverse' in the field Name")
+}
+
+func Test_validatorImpl_Normalize_BeforeValidation(t *testing.T) {
+	ctx := context.Background()
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	input := struct {
+		CPF  string `json:"cpf" normalize:"trim,unmask_cpf" validate:"cpf,len=11"`
+		Name string `json:"name" normalize:"trim" validate:"required"`
+	}{
+		CPF:  " 123.456.789-09 ",
+		Name: "   ",
+	}
+
+	assert.NoError(t, v.Normalize(ctx, &input))
+	assert.Equal(t, "12345678909", input.CPF)
+
+	err = v.ValidateStruct(ctx, input)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "The field 'name' is required")
+	assert.NotContains(t, err.Error(), "'cpf'")
+}
@@ -0,0 +1,326 @@
+package validator
+
+import (
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// SchemaConstraints are the OpenAPI schema keywords that describe the validate tag of a field.
+// It marshals to JSON with the OpenAPI names, so it can be merged into the schema of the property
+// by goswag, swag or any other spec generator.
+type SchemaConstraints struct {
+	// Required is not a keyword of the property: it should be added to the required list of the parent object
+	Required bool `json:"-"`
+
+	Format           string   `json:"format,omitempty"`
+	Pattern          string   `json:"pattern,omitempty"`
+	Enum             []any    `json:"enum,omitempty"`
+	Minimum          *float64 `json:"minimum,omitempty"`
+	Maximum          *float64 `json:"maximum,omitempty"`
+	ExclusiveMinimum bool     `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum bool     `json:"exclusiveMaximum,omitempty"`
+	MinLength        *int     `json:"minLength,omitempty"`
+	MaxLength        *int     `json:"maxLength,omitempty"`
+	MinItems         *int     `json:"minItems,omitempty"`
+	MaxItems         *int     `json:"maxItems,omitempty"`
+
+	// Items are the constraints of the elements of a slice, from the tags after dive
+	Items *SchemaConstraints `json:"items,omitempty"`
+}
+
+var (
+	// schemaConstraintsMu guards tagSchemaConstraints, which can be extended with RegisterSchemaConstraints
+	schemaConstraintsMu sync.RWMutex
+
+	// tagSchemaConstraints are the constraints of the tags that have no param, e.g. email and cpf
+	tagSchemaConstraints = map[string]SchemaConstraints{
+		"email":        {Format: "email"},
+		"uuid":         {Format: "uuid"},
+		"uuid4":        {Format: "uuid"},
+		"url":          {Format: "uri"},
+		"uri":          {Format: "uri"},
+		"hostname":     {Format: "hostname"},
+		"ipv4":         {Format: "ipv4"},
+		"ipv6":         {Format: "ipv6"},
+		"date":         {Format: "date"},
+		"datetime_api": {Format: "date-time"},
+		"e164":         {Pattern: `^\+[1-9]?[0-9]{7,14}$`},
+		"numeric":      {Pattern: `^[-+]?[0-9]+(?:\.[0-9]+)?$`},
+		"alpha":        {Pattern: `^[a-zA-Z]+$`},
+		"alphanum":     {Pattern: `^[a-zA-Z0-9]+$`},
+
+		// custom tags, the patterns describe the format, the check digits are validated only by the API
+		"cpf":            {Pattern: `^[0-9]{3}\.?[0-9]{3}\.?[0-9]{3}-?[0-9]{2}$`},
+		"cnpj":           {Pattern: `^[0-9A-Za-z]{2}\.?[0-9A-Za-z]{3}\.?[0-9A-Za-z]{3}/?[0-9A-Za-z]{4}-?[0-9]{2}$`},
+		"cep":            {Pattern: `^[0-9]{5}-?[0-9]{3}$`},
+		"cnh":            {Pattern: `^[0-9]{11}$`},
+		"renavam":        {Pattern: `^[0-9]{9}([0-9]{2})?$`},
+		"pis":            {Pattern: `^[0-9]{3}\.?[0-9]{5}\.?[0-9]{2}-?[0-9]$`},
+		"titulo_eleitor": {Pattern: `^[0-9]{4} ?[0-9]{4} ?[0-9]{4}$`},
+		"plate":          {Pattern: `^[A-Za-z]{3}-?[0-9][A-Za-z0-9][0-9]{2}$`},
+		"phone":          {Pattern: `^\+?[0-9 ().-]{8,20}$`},
+		"cns":            {Pattern: `^[0-9]{3} ?[0-9]{4} ?[0-9]{4} ?[0-9]{4}$`},
+		"required_trim":  {Required: true, Pattern: `\S`},
+	}
+
+	// numericCNPJPattern is the pattern of cnpj=numeric
+	numericCNPJPattern = `^[0-9]{2}\.?[0-9]{3}\.?[0-9]{3}/?[0-9]{4}-?[0-9]{2}$`
+
+	// oneOfValuesRegex splits the values of oneof, which can be quoted to have spaces, as go-playground does
+	oneOfValuesRegex = regexp.MustCompile(`'[^']*'|\S+`)
+)
+
+// RegisterSchemaConstraints sets the constraints of a tag without param, usually a custom validation,
+// e.g. RegisterSchemaConstraints("parking_spot", SchemaConstraints{Pattern: `^[A-Z][0-9]{3}$`})
+func RegisterSchemaConstraints(tag string, constraints SchemaConstraints) {
+	schemaConstraintsMu.Lock()
+	defer schemaConstraintsMu.Unlock()
+	tagSchemaConstraints[tag] = constraints
+}
+
+// FieldSchemaConstraints returns the OpenAPI constraints of the validate tag of the field.
+// The tags min, max, len, gt, gte, lt and lte are converted by the field type: length for strings,
+// items for slices, arrays and maps and value for numbers. Tags with the or operator (|) are skipped,
+// since they can not be described by a single schema.
+// With a validator created with WithTagName, use its FieldSchemaConstraints method to read the configured tag.
+func FieldSchemaConstraints(field reflect.StructField) SchemaConstraints {
+	return fieldSchemaConstraints(field, defaultTagName)
+}
+
+// StructSchemaConstraints returns the OpenAPI constraints of the fields of the struct, keyed by the path
+// of the field as the client sends it, e.g. address.zip_code, the same names of FieldError.
+// The fields of nested structs and of structs inside slices are included, e.g. items[].sku.
+// The fields without constraints are not included.
+// With a validator created with WithTagName, use its StructSchemaConstraints method to read the configured tag.
+func StructSchemaConstraints(dataSet any) map[string]SchemaConstraints {
+	return structSchemaConstraintsByTag(dataSet, defaultTagName)
+}
+
+func (v *validatorImpl) FieldSchemaConstraints(field reflect.StructField) SchemaConstraints {
+	return fieldSchemaConstraints(field, v.tagName)
+}
+
+func (v *validatorImpl) StructSchemaConstraints(dataSet any) map[string]SchemaConstraints {
+	return structSchemaConstraintsByTag(dataSet, v.tagName)
+}
+
+func fieldSchemaConstraints(field reflect.StructField, tagName string) SchemaConstraints {
+	return tagConstraints(field.Tag.Get(tagName), field.Type)
+}
+
+func structSchemaConstraintsByTag(dataSet any, tagName string) map[string]SchemaConstraints {
+	constraints := map[string]SchemaConstraints{}
+	structSchemaConstraints(indirectType(reflect.TypeOf(dataSet)), "", tagName, constraints, map[reflect.Type]bool{})
+	return constraints
+}
+
+func structSchemaConstraints(typ reflect.Type, prefix, tagName string, constraints map[string]SchemaConstraints, visited map[reflect.Type]bool) {
+	if typ == nil || typ.Kind() != reflect.Struct || visited[typ] {
+		return
+	}
+	visited[typ] = true
+	defer delete(visited, typ)
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		if field.Anonymous && indirectType(field.Type).Kind() == reflect.Struct {
+			structSchemaConstraints(indirectType(field.Type), prefix, tagName, constraints, visited)
+			continue
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		name := fieldName(field)
+		if name == "" {
+			name = field.Name
+		}
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+
+		fieldConstraints := fieldSchemaConstraints(field, tagName)
+		if !reflect.DeepEqual(fieldConstraints, SchemaConstraints{}) {
+			constraints[path] = fieldConstraints
+		}
+
+		elem := indirectType(field.Type)
+		switch elem.Kind() {
+		case reflect.Struct:
+			structSchemaConstraints(elem, path, tagName, constraints, visited)
+		case reflect.Slice, reflect.Array:
+			structSchemaConstraints(indirectType(elem.Elem()), path+"[]", tagName, constraints, visited)
+		}
+	}
+}
+
+// tagConstraints converts the tag of a value of the type, the tags after dive are converted for the elements
+func tagConstraints(tag string, typ reflect.Type) SchemaConstraints {
+	typ = indirectType(typ)
+	constraints := SchemaConstraints{}
+
+	rules := strings.Split(tag, ",")
+	for i := 0; i < len(rules); i++ {
+		rule := strings.TrimSpace(rules[i])
+		name, param, _ := strings.Cut(rule, "=")
+
+		switch {
+		case name == "dive":
+			if typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array {
+				items := tagConstraints(strings.Join(rules[i+1:], ","), typ.Elem())
+				if !reflect.DeepEqual(items, SchemaConstraints{}) {
+					constraints.Items = &items
+				}
+			}
+			return constraints
+
+		case name == "keys":
+			// the constraints of the map keys can not be described, skip until endkeys
+			for i < len(rules) && strings.TrimSpace(rules[i]) != "endkeys" {
+				i++
+			}
+
+		case strings.Contains(rule, "|"):
+			continue
+
+		case name == "required":
+			constraints.Required = true
+
+		case name == "oneof":
+			for _, value := range oneOfValuesRegex.FindAllString(param, -1) {
+				constraints.Enum = append(constraints.Enum, enumValue(strings.ReplaceAll(value, "'", ""), typ))
+			}
+
+		case name == "len" || name == "min" || name == "max" || name == "gt" || name == "gte" || name == "lt" || name == "lte":
+			setLimit(&constraints, name, param, typ)
+
+		case name == "cnpj" && param == "numeric":
+			constraints.Pattern = numericCNPJPattern
+
+		default:
+			schemaConstraintsMu.RLock()
+			known, ok := tagSchemaConstraints[name]
+			schemaConstraintsMu.RUnlock()
+
+			if ok {
+				constraints.merge(known)
+			}
+		}
+	}
+
+	return constraints
+}
+
+// merge sets the keywords that are set in other
+func (c *SchemaConstraints) merge(other SchemaConstraints) {
+	c.Required = c.Required || other.Required
+	c.ExclusiveMinimum = c.ExclusiveMinimum || other.ExclusiveMinimum
+	c.ExclusiveMaximum = c.ExclusiveMaximum || other.ExclusiveMaximum
+
+	if other.Format != "" {
+		c.Format = other.Format
+	}
+	if other.Pattern != "" {
+		c.Pattern = other.Pattern
+	}
+	if other.Enum != nil {
+		c.Enum = other.Enum
+	}
+	if other.Minimum != nil {
+		c.Minimum = other.Minimum
+	}
+	if other.Maximum != nil {
+		c.Maximum = other.Maximum
+	}
+	if other.MinLength != nil {
+		c.MinLength = other.MinLength
+	}
+	if other.MaxLength != nil {
+		c.MaxLength = other.MaxLength
+	}
+	if other.MinItems != nil {
+		c.MinItems = other.MinItems
+	}
+	if other.MaxItems != nil {
+		c.MaxItems = other.MaxItems
+	}
+	if other.Items != nil {
+		c.Items = other.Items
+	}
+}
+
+// setLimit sets the limit of the tag as length, items or value, depending on the type
+func setLimit(constraints *SchemaConstraints, tag, param string, typ reflect.Type) {
+	switch typ.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+		n, err := strconv.Atoi(param)
+		if err != nil {
+			return
+		}
+
+		// gt and lt are exclusive, but lengths are integers
+		switch tag {
+		case "gt":
+			n++
+		case "lt":
+			n--
+		}
+
+		minimum, maximum := &constraints.MinLength, &constraints.MaxLength
+		if typ.Kind() != reflect.String {
+			minimum, maximum = &constraints.MinItems, &constraints.MaxItems
+		}
+
+		switch tag {
+		case "len":
+			*minimum, *maximum = &n, &n
+		case "min", "gte", "gt":
+			*minimum = &n
+		case "max", "lte", "lt":
+			*maximum = &n
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(param, 64)
+		if err != nil {
+			return
+		}
+
+		switch tag {
+		case "len":
+			constraints.Minimum, constraints.Maximum = &n, &n
+		case "min", "gte":
+			constraints.Minimum = &n
+		case "gt":
+			constraints.Minimum, constraints.ExclusiveMinimum = &n, true
+		case "max", "lte":
+			constraints.Maximum = &n
+		case "lt":
+			constraints.Maximum, constraints.ExclusiveMaximum = &n, true
+		}
+	}
+}
+
+// enumValue returns the oneof value as a number for the numeric types
+func enumValue(value string, typ reflect.Type) any {
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return n
+		}
+	case reflect.Float32, reflect.Float64:
+		if n, err := strconv.ParseFloat(value, 64); err == nil {
+			return n
+		}
+	}
+	return value
+}
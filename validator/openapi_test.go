@@ -0,0 +1,140 @@
+package validator
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldSchemaConstraints(t *testing.T) {
+	type input struct {
+		Name     string            `validate:"required,min=3,max=50"`
+		Code     string            `validate:"len=6"`
+		Nickname string            `validate:"omitempty,gt=2,lt=10"`
+		Age      int               `validate:"gte=18,lte=130"`
+		Score    float64           `validate:"gt=0,lt=10"`
+		Status   string            `validate:"oneof=active inactive 'on hold'"`
+		Level    int               `validate:"oneof=1 2 3"`
+		Email    string            `validate:"required,email"`
+		ID       string            `validate:"uuid4"`
+		CPF      string            `validate:"cpf"`
+		CNPJ     *string           `validate:"omitempty,cnpj=numeric"`
+		Document string            `validate:"cpf|cnpj"`
+		Tags     []string          `validate:"min=1,max=5,dive,min=2"`
+		Labels   map[string]string `validate:"max=3,dive,keys,min=1,endkeys,required"`
+		Untagged string
+	}
+
+	intPtr := func(n int) *int { return &n }
+	floatPtr := func(n float64) *float64 { return &n }
+
+	tests := []struct {
+		field string
+		want  SchemaConstraints
+	}{
+		{field: "Name", want: SchemaConstraints{Required: true, MinLength: intPtr(3), MaxLength: intPtr(50)}},
+		{field: "Code", want: SchemaConstraints{MinLength: intPtr(6), MaxLength: intPtr(6)}},
+		{field: "Nickname", want: SchemaConstraints{MinLength: intPtr(3), MaxLength: intPtr(9)}},
+		{field: "Age", want: SchemaConstraints{Minimum: floatPtr(18), Maximum: floatPtr(130)}},
+		{field: "Score", want: SchemaConstraints{Minimum: floatPtr(0), ExclusiveMinimum: true, Maximum: floatPtr(10), ExclusiveMaximum: true}},
+		{field: "Status", want: SchemaConstraints{Enum: []any{"active", "inactive", "on hold"}}},
+		{field: "Level", want: SchemaConstraints{Enum: []any{int64(1), int64(2), int64(3)}}},
+		{field: "Email", want: SchemaConstraints{Required: true, Format: "email"}},
+		{field: "ID", want: SchemaConstraints{Format: "uuid"}},
+		{field: "CPF", want: SchemaConstraints{Pattern: `^[0-9]{3}\.?[0-9]{3}\.?[0-9]{3}-?[0-9]{2}$`}},
+		{field: "CNPJ", want: SchemaConstraints{Pattern: numericCNPJPattern}},
+		{field: "Document", want: SchemaConstraints{}},
+		{field: "Tags", want: SchemaConstraints{MinItems: intPtr(1), MaxItems: intPtr(5), Items: &SchemaConstraints{MinLength: intPtr(2)}}},
+		{field: "Labels", want: SchemaConstraints{MaxItems: intPtr(3)}},
+		{field: "Untagged", want: SchemaConstraints{}},
+	}
+
+	typ := reflect.TypeFor[input]()
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			field, _ := typ.FieldByName(tt.field)
+			assert.Equal(t, tt.want, FieldSchemaConstraints(field))
+		})
+	}
+}
+
+func TestSchemaConstraints_JSON(t *testing.T) {
+	type input struct {
+		Age  int      `validate:"required,gt=17"`
+		Tags []string `validate:"dive,oneof=a b"`
+	}
+
+	typ := reflect.TypeFor[input]()
+
+	age, _ := json.Marshal(FieldSchemaConstraints(typ.Field(0)))
+	assert.JSONEq(t, `{"minimum": 17, "exclusiveMinimum": true}`, string(age))
+
+	tags, _ := json.Marshal(FieldSchemaConstraints(typ.Field(1)))
+	assert.JSONEq(t, `{"items": {"enum": ["a", "b"]}}`, string(tags))
+}
+
+func TestStructSchemaConstraints(t *testing.T) {
+	type address struct {
+		ZipCode string `json:"zip_code" validate:"required,cep"`
+		Number  string `json:"number"`
+	}
+	type item struct {
+		SKU string `json:"sku" validate:"required,max=20"`
+	}
+	type base struct {
+		ID string `json:"id" validate:"uuid"`
+	}
+	type order struct {
+		base
+		Address address `json:"address"`
+		Items   []item  `json:"items" validate:"min=1,dive"`
+		Note    string  `json:"-" validate:"max=100"`
+	}
+
+	RegisterSchemaConstraints("sku_code", SchemaConstraints{Pattern: `^[A-Z0-9-]+$`})
+
+	maxSKU := 20
+	minItems := 1
+	maxNote := 100
+	assert.Equal(t, map[string]SchemaConstraints{
+		"id":               {Format: "uuid"},
+		"address.zip_code": {Required: true, Pattern: `^[0-9]{5}-?[0-9]{3}$`},
+		"items":            {MinItems: &minItems},
+		"items[].sku":      {Required: true, MaxLength: &maxSKU},
+		"Note":             {MaxLength: &maxNote},
+	}, StructSchemaConstraints(&order{}))
+
+	type product struct {
+		SKU string `json:"sku" validate:"sku_code"`
+	}
+	assert.Equal(t, map[string]SchemaConstraints{
+		"sku": {Pattern: `^[A-Z0-9-]+$`},
+	}, StructSchemaConstraints(product{}))
+}
+
+func Test_validatorImpl_StructSchemaConstraints(t *testing.T) {
+	type address struct {
+		ZipCode string `json:"zip_code" binding:"required,cep"`
+	}
+	type user struct {
+		Name    string  `json:"name" binding:"required,max=50"`
+		Email   string  `json:"email" validate:"email"`
+		Address address `json:"address"`
+	}
+
+	v, err := NewValidator(WithTagName("binding"))
+	assert.NoError(t, err)
+
+	maxName := 50
+	assert.Equal(t, map[string]SchemaConstraints{
+		"name":             {Required: true, MaxLength: &maxName},
+		"address.zip_code": {Required: true, Pattern: `^[0-9]{5}-?[0-9]{3}$`},
+	}, v.StructSchemaConstraints(user{}))
+	assert.Equal(t, SchemaConstraints{Required: true, MaxLength: &maxName}, v.FieldSchemaConstraints(reflect.TypeFor[user]().Field(0)))
+
+	assert.Equal(t, map[string]SchemaConstraints{
+		"email": {Format: "email"},
+	}, StructSchemaConstraints(user{}))
+}
@@ -0,0 +1,132 @@
+package validator
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Option configures the validator created by NewValidator
+type Option func(*options)
+
+type options struct {
+	validationBudget  time.Duration
+	passwordPolicy    *PasswordPolicy
+	tagName           string
+	messages          *Messages
+	defaultLocale     string
+	failFast          bool
+	maxErrors         int
+	messageCauses     bool
+	expressionTypes   []any
+	customValidations []customValidation
+	clock             func() time.Time
+}
+
+// customValidation is a validation added with WithCustomValidation or WithCustomValidationCtx,
+// only one of fn and fnCtx is set
+type customValidation struct {
+	tag   string
+	fn    validator.Func
+	fnCtx ValidationFuncCtx
+}
+
+// WithValidationBudget limits the time of each ValidateStruct call, including all the validations registered
+// with RegisterValidationCtx. When the budget is exceeded, the context given to these validations is canceled
+// and ValidateStruct returns an internal server error. There is no budget by default.
+func WithValidationBudget(budget time.Duration) Option {
+	return func(o *options) {
+		o.validationBudget = budget
+	}
+}
+
+// WithPasswordPolicy sets the rules checked by the password tag, DefaultPasswordPolicy by default
+func WithPasswordPolicy(policy PasswordPolicy) Option {
+	return func(o *options) {
+		o.passwordPolicy = &policy
+	}
+}
+
+// WithTagName sets the struct tag with the validation rules, validate by default
+func WithTagName(name string) Option {
+	return func(o *options) {
+		o.tagName = name
+	}
+}
+
+// WithMessages sets the catalog used to build the messages of the errors, NewMessages() by default.
+// The catalog can be shared by several validators, the templates registered with RegisterMessage are added to it.
+func WithMessages(messages *Messages) Option {
+	return func(o *options) {
+		o.messages = messages
+	}
+}
+
+// WithDefaultLocale sets the locale of the messages when the context has no locale (see ContextWithLocale),
+// DefaultLocale by default
+func WithDefaultLocale(locale string) Option {
+	return func(o *options) {
+		o.defaultLocale = locale
+	}
+}
+
+// WithCustomValidation adds a validation for the tag, as RegisterValidation does.
+// It can replace the built-in validations, e.g. to use another cpf rule.
+func WithCustomValidation(tag string, fn validator.Func) Option {
+	return func(o *options) {
+		o.customValidations = append(o.customValidations, customValidation{tag: tag, fn: fn})
+	}
+}
+
+// WithCustomValidationCtx adds a validation that receives the context of ValidateStruct, as RegisterValidationCtx does
+func WithCustomValidationCtx(tag string, fn ValidationFuncCtx) Option {
+	return func(o *options) {
+		o.customValidations = append(o.customValidations, customValidation{tag: tag, fnCtx: fn})
+	}
+}
+
+// WithFailFast makes the validations report only the first invalid field, for the callers that only need
+// to know whether the input is valid. It only truncates the errors: ValidateStruct, ValidatePatch and ValidateMap
+// still validate all the fields. ValidateSlice stops at the first invalid item, without validating the next ones.
+func WithFailFast() Option {
+	return func(o *options) {
+		o.failFast = true
+	}
+}
+
+// WithMaxErrors limits the field errors reported by each call of ValidateStruct, ValidatePatch, ValidateMap
+// and ValidateSlice. Like WithFailFast, it only truncates the errors of a struct or a map, which are fully validated,
+// and ValidateSlice stops validating the items when the limit is reached. There is no limit by default.
+func WithMaxErrors(maxErrors int) Option {
+	return func(o *options) {
+		o.maxErrors = maxErrors
+	}
+}
+
+// WithMessageCauses makes ValidateStruct, ValidatePatch, ValidateMap and Bind return the messages of the invalid fields
+// as causes, a list of strings, instead of a list of FieldError, including the values of the wrong type and the unknown
+// fields. It keeps the response of the clients that read the causes of the previous versions, which can migrate later.
+// FieldErrors returns nil for these errors.
+func WithMessageCauses() Option {
+	return func(o *options) {
+		o.messageCauses = true
+	}
+}
+
+// WithClock sets the function that returns the current time, used by the date tags like past, min_age and within_days,
+// dateutils.GetDateNowTime by default. Tests can use it to validate against a fixed date.
+func WithClock(now func() time.Time) Option {
+	return func(o *options) {
+		o.clock = now
+	}
+}
+
+// WithExpressionChecks compiles the expressions of the required_when and excluded_when tags of the struct types,
+// including their nested structs, e.g. WithExpressionChecks(CreateUserRequest{}), so NewValidator returns an error
+// when an expression is malformed or uses a field that does not exist. Without it, the expressions of a type are
+// checked on its first validation, which returns the error as an internal server error.
+func WithExpressionChecks(types ...any) Option {
+	return func(o *options) {
+		o.expressionTypes = append(o.expressionTypes, types...)
+	}
+}
@@ -0,0 +1,165 @@
+package validator
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/go-playground/validator/v10"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewValidator_Options(t *testing.T) {
+	type user struct {
+		Name  string `json:"name" rules:"required" validate:"email"`
+		Email string `json:"email" rules:"required,email"`
+	}
+
+	t.Run("tag name", func(t *testing.T) {
+		v, err := NewValidator(WithTagName("rules"))
+		assert.NoError(t, err)
+
+		err = v.ValidateStruct(context.Background(), user{Name: "John", Email: "john"})
+		assert.Equal(t, []FieldError{
+			{Field: "email", Tag: "email", Value: "john", Message: "The field 'email' should be a valid email"},
+		}, FieldErrors(err))
+	})
+
+	t.Run("messages and default locale", func(t *testing.T) {
+		messages := NewMessages()
+		messages.Register(LocalePTBR, "required", "Informe o campo '{field}'")
+
+		v, err := NewValidator(WithTagName("rules"), WithMessages(messages), WithDefaultLocale(LocalePTBR))
+		assert.NoError(t, err)
+
+		err = v.ValidateStruct(context.Background(), user{Email: "john@example.com"})
+		assert.Equal(t, []FieldError{
+			{Field: "name", Tag: "required", Message: "Informe o campo 'name'"},
+		}, FieldErrors(err))
+
+		// the locale of the context has precedence
+		err = v.ValidateStruct(ContextWithLocale(context.Background(), LocaleEN), user{Email: "john@example.com"})
+		assert.Equal(t, "The field 'name' is required", FieldErrors(err)[0].Message)
+	})
+
+	t.Run("custom validations", func(t *testing.T) {
+		evenLength := func(fl validator.FieldLevel) bool {
+			return len(fl.Field().String())%2 == 0
+		}
+		anyCPF := func(ctx context.Context, fl validator.FieldLevel) (bool, error) {
+			return fl.Field().String() != "", nil
+		}
+
+		v, err := NewValidator(WithTagName("rules"), WithCustomValidation("even_length", evenLength), WithCustomValidationCtx("cpf", anyCPF))
+		assert.NoError(t, err)
+
+		type coupon struct {
+			Code string `json:"code" rules:"even_length"`
+		}
+
+		err = v.ValidateStruct(context.Background(), coupon{Code: "abc"})
+		assert.Equal(t, []FieldError{
+			{Field: "code", Tag: "even_length", Value: "abc", Message: "The field 'code' is invalid."},
+		}, FieldErrors(err))
+
+		// the built-in cpf validation is replaced
+		assert.NoError(t, v.Var("123", "cpf"))
+	})
+
+	t.Run("invalid custom validation", func(t *testing.T) {
+		v, err := NewValidator(WithCustomValidation("", func(fl validator.FieldLevel) bool { return true }))
+		assert.Nil(t, v)
+
+		restErr, ok := err.(resterrors.RestErr)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusInternalServerError, restErr.StatusCode())
+	})
+
+	t.Run("fail fast", func(t *testing.T) {
+		v, err := NewValidator(WithTagName("rules"), WithFailFast())
+		assert.NoError(t, err)
+
+		err = v.ValidateStruct(context.Background(), user{Email: "john"})
+		assert.Equal(t, []FieldError{
+			{Field: "name", Tag: "required", Message: "The field 'name' is required"},
+		}, FieldErrors(err))
+
+		// the first error of a patch is the first of the fields sent
+		var current user
+		err = v.ValidatePatch(context.Background(), []byte(`{"email": "john"}`), &current)
+		assert.Equal(t, []FieldError{
+			{Field: "email", Tag: "email", Value: "john", Message: "The field 'email' should be a valid email"},
+		}, FieldErrors(err))
+	})
+
+	t.Run("max errors", func(t *testing.T) {
+		type address struct {
+			Street string `json:"street" validate:"required"`
+			City   string `json:"city" validate:"required"`
+			State  string `json:"state" validate:"required,len=2"`
+		}
+
+		v, err := NewValidator(WithMaxErrors(2))
+		assert.NoError(t, err)
+
+		err = v.ValidateStruct(context.Background(), address{})
+		assert.Equal(t, []FieldError{
+			{Field: "street", Tag: "required", Message: "The field 'street' is required"},
+			{Field: "city", Tag: "required", Message: "The field 'city' is required"},
+		}, FieldErrors(err))
+
+		err = v.ValidateMap(context.Background(), map[string]any{}, map[string]any{"a": "required", "b": "required", "c": "required"})
+		assert.Len(t, FieldErrors(err), 2)
+	})
+
+	t.Run("message causes", func(t *testing.T) {
+		v, err := NewValidator(WithTagName("rules"), WithMessageCauses())
+		assert.NoError(t, err)
+
+		err = v.ValidateStruct(context.Background(), user{Email: "john"})
+
+		var restErr resterrors.RestErr
+		if assert.ErrorAs(t, err, &restErr) {
+			assert.Equal(t, http.StatusUnprocessableEntity, restErr.StatusCode())
+			assert.Equal(t, []any{[]string{
+				"The field 'name' is required",
+				"The field 'email' should be a valid email",
+			}}, restErr.Causes())
+		}
+		assert.Nil(t, FieldErrors(err))
+
+		// the values of the wrong type also have the messages as causes
+		var current user
+		err = v.ValidatePatch(context.Background(), []byte(`{"email": 1}`), &current)
+		if assert.ErrorAs(t, err, &restErr) {
+			assert.Equal(t, http.StatusBadRequest, restErr.StatusCode())
+			assert.Equal(t, []any{[]string{"The field 'email' should be of type string"}}, restErr.Causes())
+		}
+
+		err = v.ValidateMap(context.Background(), map[string]any{"address": "Paulista"}, map[string]any{
+			"address": map[string]any{"zip_code": "required"},
+		})
+		if assert.ErrorAs(t, err, &restErr) {
+			assert.Equal(t, []any{[]string{"The field 'address' should be of type object"}}, restErr.Causes())
+		}
+	})
+}
+
+func TestMockValidator(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mock := NewMockValidator(ctrl)
+	mock.EXPECT().Normalize(gomock.Any(), gomock.Any()).Return(nil)
+	mock.EXPECT().ValidateStruct(gomock.Any(), gomock.Any()).Return(resterrors.NewUnprocessableEntity("Invalid input data", []FieldError{
+		{Field: "name", Tag: "required", Message: "The field 'name' is required"},
+	}))
+
+	type request struct {
+		Name string `json:"name"`
+	}
+
+	_, err := Bind[request](newBindRequest("/users", `{"name": "John"}`), WithBindValidator(mock))
+	assert.Equal(t, []FieldError{{Field: "name", Tag: "required", Message: "The field 'name' is required"}}, FieldErrors(err))
+}
@@ -0,0 +1,240 @@
+package validator
+
+import (
+	"context"
+	_ "embed"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// PasswordPolicy are the rules checked by the password tag. Each rule that fails is reported as its own
+// FieldError, with the tag password and the code of the rule, e.g. password_min_length.
+type PasswordPolicy struct {
+	// MinLength and MaxLength are the limits of characters, 0 disables the limit (codes password_min_length and password_max_length)
+	MinLength int
+	MaxLength int
+
+	// RequireUpper, RequireLower, RequireDigit and RequireSymbol require at least one character of the class
+	// (codes password_upper, password_lower, password_digit and password_symbol)
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+
+	// MaxRepeated is the max times a character can be repeated in a row, e.g. 2 rejects aaa, 0 disables the rule (code password_repeated)
+	MaxRepeated int
+
+	// CheckBlocklist rejects the common passwords embedded in the package and the ones of Blocklist, ignoring case (code password_common)
+	CheckBlocklist bool
+	Blocklist      []string
+
+	// UserInfoFields are the sibling fields with the user data, e.g. the email and the name, that can not be part of the password.
+	// The tag param overrides them, e.g. password=Email FullName (code password_user_info)
+	UserInfoFields []string
+}
+
+// DefaultPasswordPolicy returns the policy used by the password tag when none is set with WithPasswordPolicy:
+// 8 to 64 characters with uppercase and lowercase letters and digits, no character repeated more than 3 times in a row,
+// not a common password and not containing the Email or Name sibling fields.
+func DefaultPasswordPolicy() PasswordPolicy {
+	return PasswordPolicy{
+		MinLength:      8,
+		MaxLength:      64,
+		RequireUpper:   true,
+		RequireLower:   true,
+		RequireDigit:   true,
+		MaxRepeated:    3,
+		CheckBlocklist: true,
+		UserInfoFields: []string{"Email", "Name"},
+	}
+}
+
+// Password rule codes, reported in FieldError.Code
+const (
+	PasswordMinLength = "password_min_length"
+	PasswordMaxLength = "password_max_length"
+	PasswordUpper     = "password_upper"
+	PasswordLower     = "password_lower"
+	PasswordDigit     = "password_digit"
+	PasswordSymbol    = "password_symbol"
+	PasswordRepeated  = "password_repeated"
+	PasswordCommon    = "password_common"
+	PasswordUserInfo  = "password_user_info"
+)
+
+// minUserInfoLength is the min length of a part of the email or name checked in the password, to ignore short words like "da"
+const minUserInfoLength = 3
+
+//go:embed common_passwords.txt
+var commonPasswordsFile string
+
+// commonPasswords is the embedded blocklist, in lowercase
+var commonPasswords = parseBlocklist(commonPasswordsFile)
+
+func parseBlocklist(file string) map[string]bool {
+	blocklist := map[string]bool{}
+	for _, line := range strings.Split(file, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		blocklist[strings.ToLower(line)] = true
+	}
+	return blocklist
+}
+
+// validPassword checks the password with the policy, registering the failed rules in the validation state,
+// so each one is reported as its own FieldError.
+func (v *validatorImpl) validPassword(ctx context.Context, fl validator.FieldLevel) bool {
+	if fl.Field().Kind() != reflect.String {
+		return false
+	}
+
+	userInfoFields := v.passwordPolicy.UserInfoFields
+	if fl.Param() != "" {
+		userInfoFields = strings.Fields(fl.Param())
+	}
+
+	failed := v.passwordPolicy.check(fl.Field().String(), passwordUserInfo(fl, userInfoFields))
+	if len(failed) == 0 {
+		return true
+	}
+
+	if state := validationStateFromContext(ctx); state != nil {
+		state.addRuleFailure("password", fl.StructFieldName(), failed)
+	}
+	return false
+}
+
+// check returns the rules of the policy that the password does not follow
+func (p PasswordPolicy) check(password string, userInfo []string) []failedRule {
+	var failed []failedRule
+
+	length := utf8.RuneCountInString(password)
+	if p.MinLength > 0 && length < p.MinLength {
+		failed = append(failed, failedRule{code: PasswordMinLength, param: strconv.Itoa(p.MinLength)})
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		failed = append(failed, failedRule{code: PasswordMaxLength, param: strconv.Itoa(p.MaxLength)})
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case !unicode.IsLetter(r):
+			hasSymbol = true
+		}
+	}
+
+	if p.RequireUpper && !hasUpper {
+		failed = append(failed, failedRule{code: PasswordUpper})
+	}
+	if p.RequireLower && !hasLower {
+		failed = append(failed, failedRule{code: PasswordLower})
+	}
+	if p.RequireDigit && !hasDigit {
+		failed = append(failed, failedRule{code: PasswordDigit})
+	}
+	if p.RequireSymbol && !hasSymbol {
+		failed = append(failed, failedRule{code: PasswordSymbol})
+	}
+
+	if p.MaxRepeated > 0 && maxRepeatedInARow(password) > p.MaxRepeated {
+		failed = append(failed, failedRule{code: PasswordRepeated, param: strconv.Itoa(p.MaxRepeated)})
+	}
+
+	lower := strings.ToLower(password)
+	if p.CheckBlocklist && p.isBlocked(lower) {
+		failed = append(failed, failedRule{code: PasswordCommon})
+	}
+
+	for _, info := range userInfo {
+		if containsUserInfo(lower, info) {
+			failed = append(failed, failedRule{code: PasswordUserInfo})
+			break
+		}
+	}
+
+	return failed
+}
+
+func (p PasswordPolicy) isBlocked(lowerPassword string) bool {
+	if commonPasswords[lowerPassword] {
+		return true
+	}
+
+	for _, blocked := range p.Blocklist {
+		if strings.ToLower(blocked) == lowerPassword {
+			return true
+		}
+	}
+	return false
+}
+
+// maxRepeatedInARow returns the max times a character is repeated in a row
+func maxRepeatedInARow(value string) int {
+	var (
+		maxCount int
+		count    int
+		last     rune = -1
+	)
+
+	for _, r := range value {
+		if r == last {
+			count++
+		} else {
+			count = 1
+			last = r
+		}
+		maxCount = max(maxCount, count)
+	}
+
+	return maxCount
+}
+
+// containsUserInfo reports whether the password contains the email or the name, or one of their parts,
+// e.g. john.doe@example.com is checked as john.doe, john and doe, and John da Silva as john and silva.
+func containsUserInfo(lowerPassword, info string) bool {
+	info = strings.ToLower(strings.TrimSpace(info))
+	info, _, _ = strings.Cut(info, "@")
+
+	parts := strings.FieldsFunc(info, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	parts = append(parts, info)
+
+	for _, part := range parts {
+		if utf8.RuneCountInString(part) >= minUserInfoLength && strings.Contains(lowerPassword, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// passwordUserInfo returns the values of the sibling fields with the user data
+func passwordUserInfo(fl validator.FieldLevel, fields []string) []string {
+	// the values validated without a struct, e.g. by Var or ValidateMap, have no sibling fields
+	if fl.Parent().Kind() != reflect.Struct {
+		return nil
+	}
+
+	var values []string
+	for _, name := range fields {
+		field, kind, _, ok := fl.GetStructFieldOKAdvanced2(fl.Parent(), name)
+		if ok && kind == reflect.String && field.String() != "" {
+			values = append(values, field.String())
+		}
+	}
+	return values
+}
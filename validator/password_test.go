@@ -0,0 +1,122 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasswordPolicy_check(t *testing.T) {
+	strict := PasswordPolicy{
+		MinLength:      10,
+		MaxLength:      20,
+		RequireUpper:   true,
+		RequireLower:   true,
+		RequireDigit:   true,
+		RequireSymbol:  true,
+		MaxRepeated:    2,
+		CheckBlocklist: true,
+		Blocklist:      []string{"Company2026!"},
+	}
+
+	tests := []struct {
+		name     string
+		policy   PasswordPolicy
+		password string
+		userInfo []string
+		want     []string
+	}{
+		{name: "strong password", policy: strict, password: "Tr0ub4dor&3x"},
+		{name: "short", policy: strict, password: "Ab1!", want: []string{PasswordMinLength}},
+		{name: "long", policy: strict, password: "Abcdefghij1!Abcdefghij1!", want: []string{PasswordMaxLength}},
+		{name: "no uppercase", policy: strict, password: "tr0ub4dor&3x", want: []string{PasswordUpper}},
+		{name: "no lowercase", policy: strict, password: "TR0UB4DOR&3X", want: []string{PasswordLower}},
+		{name: "no digit", policy: strict, password: "Troubador&xyz", want: []string{PasswordDigit}},
+		{name: "no symbol", policy: strict, password: "Tr0ub4dor3xy", want: []string{PasswordSymbol}},
+		{name: "repeated characters", policy: strict, password: "Tr0ub4dooor&3", want: []string{PasswordRepeated}},
+		{name: "custom blocklist", policy: strict, password: "company2026!", want: []string{PasswordUpper, PasswordCommon}},
+		{name: "embedded blocklist", policy: DefaultPasswordPolicy(), password: "Password123", want: []string{PasswordCommon}},
+		{name: "brazilian common password", policy: DefaultPasswordPolicy(), password: "senha123", want: []string{PasswordUpper, PasswordCommon}},
+		{name: "contains the email", policy: strict, password: "J0hn.Doe!xyz", userInfo: []string{"john.doe@example.com"}, want: []string{PasswordUserInfo}},
+		{name: "contains a part of the name", policy: strict, password: "Silva#2026xy", userInfo: []string{"Maria da Silva"}, want: []string{PasswordUserInfo}},
+		{name: "short words of the name are ignored", policy: strict, password: "Tr0ub4dor&da", userInfo: []string{"Maria da Silva"}},
+		{
+			name:     "several rules",
+			policy:   strict,
+			password: "aaa",
+			want:     []string{PasswordMinLength, PasswordUpper, PasswordDigit, PasswordSymbol, PasswordRepeated},
+		},
+		{name: "empty policy", policy: PasswordPolicy{}, password: "a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, rule := range tt.policy.check(tt.password, tt.userInfo) {
+				got = append(got, rule.code)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_validatorImpl_ValidateStruct_Password(t *testing.T) {
+	type signUp struct {
+		Name     string `json:"name"`
+		Email    string `json:"email"`
+		Password string `json:"password" validate:"required,password"`
+	}
+
+	t.Run("each failed rule is its own field error", func(t *testing.T) {
+		v, err := NewValidator()
+		assert.NoError(t, err)
+
+		err = v.ValidateStruct(context.Background(), signUp{Name: "John Doe", Email: "john@example.com", Password: "john"})
+		assert.Equal(t, []FieldError{
+			{Field: "password", Tag: "password", Value: RedactedValue, Code: PasswordMinLength, Param: "8", Message: "The field 'password' should have at least 8 characters"},
+			{Field: "password", Tag: "password", Value: RedactedValue, Code: PasswordUpper, Message: "The field 'password' should have an uppercase letter"},
+			{Field: "password", Tag: "password", Value: RedactedValue, Code: PasswordDigit, Message: "The field 'password' should have a digit"},
+			{Field: "password", Tag: "password", Value: RedactedValue, Code: PasswordUserInfo, Message: "The field 'password' should not contain the email or the name"},
+		}, FieldErrors(err))
+	})
+
+	t.Run("valid password", func(t *testing.T) {
+		v, err := NewValidator()
+		assert.NoError(t, err)
+
+		err = v.ValidateStruct(context.Background(), signUp{Name: "John Doe", Email: "john@example.com", Password: "Tr0ub4dor&3"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("custom policy and messages in pt-BR", func(t *testing.T) {
+		policy := DefaultPasswordPolicy()
+		policy.MinLength = 12
+		policy.RequireSymbol = true
+
+		v, err := NewValidator(WithPasswordPolicy(policy))
+		assert.NoError(t, err)
+
+		ctx := ContextWithLocale(context.Background(), LocalePTBR)
+		err = v.ValidateStruct(ctx, signUp{Password: "Tr0ub4dor3"})
+		assert.Equal(t, []FieldError{
+			{Field: "password", Tag: "password", Value: RedactedValue, Code: PasswordMinLength, Param: "12", Message: "O campo 'password' deve ter no mínimo 12 caracteres"},
+			{Field: "password", Tag: "password", Value: RedactedValue, Code: PasswordSymbol, Message: "O campo 'password' deve ter um símbolo"},
+		}, FieldErrors(err))
+	})
+
+	t.Run("user info fields from the param", func(t *testing.T) {
+		type account struct {
+			Login    string `json:"login"`
+			Password string `json:"password" validate:"password=Login"`
+		}
+
+		v, err := NewValidator()
+		assert.NoError(t, err)
+
+		err = v.ValidateStruct(context.Background(), account{Login: "jdoe2026", Password: "Jdoe2026!x"})
+		assert.Equal(t, []FieldError{
+			{Field: "password", Tag: "password", Value: RedactedValue, Code: PasswordUserInfo, Message: "The field 'password' should not contain the email or the name"},
+		}, FieldErrors(err))
+	})
+}
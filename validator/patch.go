@@ -0,0 +1,237 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/diegoclair/go_utils/resterrors"
+)
+
+func (v *validatorImpl) ValidatePatch(ctx context.Context, raw []byte, dst any) error {
+	value := reflect.ValueOf(dst)
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		return resterrors.NewInternalServerError(fmt.Sprintf("Invalid argument passed to patch: expected a non nil pointer, got %T", dst))
+	}
+
+	if len(bytes.TrimSpace(raw)) == 0 {
+		return nil
+	}
+
+	err := decodeJSON(raw, dst, false, v.bindErrorFormat(ctx))
+	if err != nil {
+		return err
+	}
+
+	var sent any
+	err = json.Unmarshal(raw, &sent)
+	if err != nil {
+		return resterrors.NewBadRequestError("Invalid request body: " + err.Error())
+	}
+
+	ctx, cancel := v.budgetContext(ctx)
+	defer cancel()
+
+	// only the sent fields are validated, so the rules of the other ones, e.g. a RegisterValidationCtx
+	// that reads a database, do not run
+	paths := newSentPaths(reflect.TypeOf(dst), sent)
+	fieldErrors, err := v.structFieldErrors(ctx, dst, "", paths.skip)
+	if err != nil {
+		return err
+	}
+
+	if len(fieldErrors) == 0 {
+		return nil
+	}
+
+	return v.invalidInputError(v.limitFieldErrors(fieldErrors))
+}
+
+// sentPaths are the Go paths of the fields set by a JSON merge patch, e.g. Address.ZipCode,
+// resolving the keys of the JSON to the fields of the struct as encoding/json does
+type sentPaths struct {
+	// top is the name of the struct, which starts the namespaces of go-playground
+	top string
+	// fields are the sent fields and the structs that hold them
+	fields map[string]bool
+	// wholes are the sent fields whose paths under them are all sent: arrays and maps, which a merge patch
+	// replaces as a whole, the values that are not objects, e.g. an address sent as null, and the other fields
+	wholes map[string]bool
+}
+
+func newSentPaths(typ reflect.Type, sent any) *sentPaths {
+	typ = indirectType(typ)
+	paths := &sentPaths{top: typ.Name(), fields: map[string]bool{}, wholes: map[string]bool{}}
+	paths.add(typ, sent, "")
+	return paths
+}
+
+// add records the fields of the struct type set by the sent value, with the path as prefix
+func (p *sentPaths) add(typ reflect.Type, sent any, prefix string) {
+	typ = indirectType(typ)
+	object, ok := sent.(map[string]any)
+	if typ.Kind() != reflect.Struct || !ok {
+		p.wholes[prefix] = true
+		return
+	}
+
+	fields := patchFields(typ)
+	for key, value := range object {
+		fld, ok := matchPatchField(fields, key)
+		if !ok {
+			continue
+		}
+
+		path := fld.path
+		if prefix != "" {
+			path = prefix + "." + path
+		}
+
+		// the promoted fields of the embedded structs are under them, e.g. Base.ID
+		for i := len(prefix) + 1; i < len(path); i++ {
+			if path[i] == '.' {
+				p.fields[path[:i]] = true
+			}
+		}
+		p.fields[path] = true
+		p.add(fld.typ, value, path)
+	}
+}
+
+// skip is the go-playground FilterFunc of the fields not sent, which receives the namespaces
+// of the struct fields, e.g. User.Items[2].Sku
+func (p *sentPaths) skip(namespace []byte) bool {
+	path := string(namespace)
+	if p.top != "" {
+		path = strings.TrimPrefix(path, p.top+".")
+	}
+
+	if p.fields[path] || p.wholes[""] {
+		return false
+	}
+
+	for i := 0; i < len(path); i++ {
+		if (path[i] == '.' || path[i] == '[') && p.wholes[path[:i]] {
+			return false
+		}
+	}
+	return true
+}
+
+// patchField is a field of a struct that a JSON key sets
+type patchField struct {
+	// name is the name of the key, from the json tag or the Go name of the field
+	name string
+	// path is the Go path of the field in the struct, with the embedded structs, e.g. Base.ID
+	path string
+	typ  reflect.Type
+	// depth and tagged are used to choose between the fields with the same name, as encoding/json does
+	depth  int
+	tagged bool
+}
+
+// patchFields returns the fields of the struct that JSON keys can set, following the rules of encoding/json:
+// the json tag names the field, - ignores it, and the fields of the embedded structs without a name are promoted.
+// Only the json tag is read, since encoding/json ignores the form and query tags.
+func patchFields(typ reflect.Type) []patchField {
+	var fields []patchField
+	collectPatchFields(typ, "", 0, map[reflect.Type]bool{}, &fields)
+
+	// a name is set by the shallowest field, or by the only tagged one at that depth, as encoding/json does
+	byName := map[string][]patchField{}
+	var names []string
+	for _, fld := range fields {
+		if _, ok := byName[fld.name]; !ok {
+			names = append(names, fld.name)
+		}
+		byName[fld.name] = append(byName[fld.name], fld)
+	}
+
+	var dominant []patchField
+	for _, name := range names {
+		if fld, ok := dominantPatchField(byName[name]); ok {
+			dominant = append(dominant, fld)
+		}
+	}
+	return dominant
+}
+
+func collectPatchFields(typ reflect.Type, prefix string, depth int, visited map[reflect.Type]bool, fields *[]patchField) {
+	if visited[typ] {
+		return
+	}
+	visited[typ] = true
+	defer delete(visited, typ)
+
+	for i := 0; i < typ.NumField(); i++ {
+		fld := typ.Field(i)
+		tag := fld.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+		elem := indirectType(fld.Type)
+		if fld.Anonymous && name == "" && elem.Kind() == reflect.Struct {
+			collectPatchFields(elem, prefix+fld.Name+".", depth+1, visited, fields)
+			continue
+		}
+
+		if !fld.IsExported() {
+			continue
+		}
+
+		tagged := name != ""
+		if !tagged {
+			name = fld.Name
+		}
+		*fields = append(*fields, patchField{name: name, path: prefix + fld.Name, typ: fld.Type, depth: depth, tagged: tagged})
+	}
+}
+
+func dominantPatchField(fields []patchField) (patchField, bool) {
+	depth := fields[0].depth
+	var candidates []patchField
+	for _, fld := range fields {
+		if fld.depth < depth {
+			depth, candidates = fld.depth, nil
+		}
+		if fld.depth == depth {
+			candidates = append(candidates, fld)
+		}
+	}
+
+	if len(candidates) == 1 {
+		return candidates[0], true
+	}
+
+	var tagged []patchField
+	for _, fld := range candidates {
+		if fld.tagged {
+			tagged = append(tagged, fld)
+		}
+	}
+	if len(tagged) == 1 {
+		return tagged[0], true
+	}
+	return patchField{}, false
+}
+
+// matchPatchField returns the field set by the key: the one with the exact name,
+// or the first one with the name in another case, as encoding/json does
+func matchPatchField(fields []patchField, key string) (patchField, bool) {
+	for _, fld := range fields {
+		if fld.name == key {
+			return fld, true
+		}
+	}
+	for _, fld := range fields {
+		if strings.EqualFold(fld.name, key) {
+			return fld, true
+		}
+	}
+	return patchField{}, false
+}
@@ -0,0 +1,227 @@
+package validator
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validatorImpl_ValidatePatch(t *testing.T) {
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	type address struct {
+		Street  string `json:"street" validate:"required"`
+		ZipCode string `json:"zip_code" validate:"required,cep"`
+	}
+	type item struct {
+		SKU      string `json:"sku" validate:"required"`
+		Quantity int    `json:"quantity" validate:"min=1"`
+	}
+	type user struct {
+		Name     string            `json:"name" validate:"required"`
+		Email    string            `json:"email" validate:"required,email"`
+		Age      int               `json:"age" validate:"omitempty,min=18"`
+		Address  *address          `json:"address" validate:"omitempty"`
+		Items    []item            `json:"items" validate:"dive"`
+		Labels   map[string]string `json:"labels" validate:"dive,required"`
+		Password string            `json:"password"`
+		Confirm  string            `json:"confirm" validate:"eqfield=Password"`
+	}
+
+	tests := []struct {
+		name       string
+		current    user
+		raw        string
+		wantStatus int
+		wantFields []string
+	}{
+		{
+			name: "only the sent fields are validated",
+			raw:  `{"age": 20}`,
+		},
+		{
+			name:       "sent field is invalid",
+			raw:        `{"age": 10, "email": "john"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantFields: []string{"email:email", "age:min"},
+		},
+		{
+			name:       "sent field is cleared",
+			current:    user{Name: "John"},
+			raw:        `{"name": ""}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantFields: []string{"name:required"},
+		},
+		{
+			name:       "nested fields",
+			current:    user{Address: &address{Street: "Av. Paulista"}},
+			raw:        `{"address": {"zip_code": "123"}}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantFields: []string{"address.zip_code:cep"},
+		},
+		{
+			name:    "nested field not sent",
+			current: user{Address: &address{ZipCode: "01310-100"}},
+			raw:     `{"address": {"zip_code": "01310-200"}}`,
+		},
+		{
+			name:       "all the fields of a sent array",
+			raw:        `{"items": [{"sku": "A1", "quantity": 1}, {"quantity": 0}]}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantFields: []string{"items[1].sku:required", "items[1].quantity:min"},
+		},
+		{
+			name:       "map keys",
+			raw:        `{"labels": {"team": "core", "env": ""}}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantFields: []string{"labels[env]:required"},
+		},
+		{
+			name:       "cross field rules see the patched values",
+			current:    user{Password: "secret"},
+			raw:        `{"confirm": "other"}`,
+			wantStatus: http.StatusUnprocessableEntity,
+			wantFields: []string{"confirm:eqfield"},
+		},
+		{
+			name: "empty patch",
+			raw:  ``,
+		},
+		{
+			name:       "malformed JSON",
+			raw:        `{"age": }`,
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dst := tt.current
+			err := v.ValidatePatch(context.Background(), []byte(tt.raw), &dst)
+			if tt.wantStatus == 0 {
+				assert.NoError(t, err)
+				return
+			}
+
+			restErr, ok := err.(resterrors.RestErr)
+			assert.True(t, ok)
+			assert.Equal(t, tt.wantStatus, restErr.StatusCode())
+
+			var fields []string
+			for _, fieldErr := range FieldErrors(err) {
+				fields = append(fields, fieldErr.Field+":"+fieldErr.Tag)
+			}
+			assert.Equal(t, tt.wantFields, fields)
+		})
+	}
+
+	t.Run("dst should be a pointer", func(t *testing.T) {
+		err := v.ValidatePatch(context.Background(), []byte(`{}`), user{})
+		restErr, ok := err.(resterrors.RestErr)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusInternalServerError, restErr.StatusCode())
+	})
+}
+
+func Test_validatorImpl_ValidatePatch_untagged(t *testing.T) {
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	type Base struct {
+		ID string `validate:"omitempty,uuid4"`
+	}
+	type profile struct {
+		Base
+		Name  string `validate:"min=5"`
+		Email string `form:"mail" validate:"required,email"`
+		Alias string `json:"-" validate:"required"`
+	}
+
+	tests := []struct {
+		name       string
+		raw        string
+		wantFields []string
+	}{
+		{name: "the keys match the go names in any case", raw: `{"name": "ab"}`, wantFields: []string{"Name:min"}},
+		{name: "the form tag is not a json name", raw: `{"mail": "john"}`},
+		{name: "the errors are named by the form tag", raw: `{"EMAIL": "john"}`, wantFields: []string{"mail:email"}},
+		{name: "the promoted fields", raw: `{"id": "1"}`, wantFields: []string{"ID:uuid4"}},
+		{name: "the ignored fields are not sent", raw: `{"Alias": ""}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dst := profile{Name: "Johnny", Email: "john@example.com", Alias: "j"}
+			err := v.ValidatePatch(context.Background(), []byte(tt.raw), &dst)
+
+			var fields []string
+			for _, fieldErr := range FieldErrors(err) {
+				fields = append(fields, fieldErr.Field+":"+fieldErr.Tag)
+			}
+			assert.Equal(t, tt.wantFields, fields)
+		})
+	}
+}
+
+func Test_validatorImpl_ValidatePatch_onlySentFields(t *testing.T) {
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	var checked []string
+	err = v.RegisterValidationCtx("unique", func(ctx context.Context, fl validator.FieldLevel) (bool, error) {
+		checked = append(checked, fl.FieldName())
+		return true, nil
+	})
+	assert.NoError(t, err)
+
+	type account struct {
+		Email    string `json:"email" validate:"unique"`
+		Username string `json:"username" validate:"unique"`
+	}
+
+	dst := account{Email: "john@example.com", Username: "john"}
+	assert.NoError(t, v.ValidatePatch(context.Background(), []byte(`{"username": "johnny"}`), &dst))
+	assert.Equal(t, []string{"username"}, checked)
+}
+
+func Test_sentPaths_skip(t *testing.T) {
+	type address struct {
+		ZipCode string `json:"zip_code"`
+	}
+	type user struct {
+		Name    string            `json:"name"`
+		Address *address          `json:"address"`
+		Items   []address         `json:"items"`
+		Labels  map[string]string `json:"labels"`
+	}
+
+	paths := newSentPaths(reflect.TypeOf(&user{}), map[string]any{
+		"address": map[string]any{"zip_code": "01310-100"},
+		"items":   []any{},
+		"unknown": "x",
+	})
+
+	for namespace, want := range map[string]bool{
+		"user.Name":              true,
+		"user.Address":           false,
+		"user.Address.ZipCode":   false,
+		"user.Items":             false,
+		"user.Items[2].ZipCode":  false,
+		"user.Labels":            true,
+		"user.Labels[team]":      true,
+		"user.Address2.ZipCode":  true,
+		"user.ItemsOther[0].Sku": true,
+	} {
+		assert.Equal(t, want, paths.skip([]byte(namespace)), namespace)
+	}
+
+	// an address sent as null clears all its fields
+	paths = newSentPaths(reflect.TypeOf(user{}), map[string]any{"address": nil})
+	assert.False(t, paths.skip([]byte("user.Address.ZipCode")))
+}
@@ -0,0 +1,242 @@
+package validator
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// PhoneType is the type of a phone number, known only for the brazilian numbers
+type PhoneType string
+
+// Phone types
+const (
+	PhoneMobile   PhoneType = "mobile"
+	PhoneLandline PhoneType = "landline"
+	PhoneUnknown  PhoneType = ""
+)
+
+// PhoneRegionBR is the region of the brazilian numbers, the default region of the numbers without the country code
+const PhoneRegionBR = "BR"
+
+// Phone is a phone number parsed by ParsePhone
+type Phone struct {
+	// E164 is the canonical form of the number, e.g. +5511912345678
+	E164 string
+	// CountryCode is the calling code of the country, e.g. 55
+	CountryCode string
+	// Region is the ISO 3166-1 alpha-2 code of the country of the calling code, e.g. BR.
+	// The calling codes shared by several countries have the region of the main one, e.g. US for 1.
+	Region string
+	// AreaCode is the DDD of the brazilian numbers, e.g. 11, and empty for the other countries
+	AreaCode string
+	// Number is the national number, without the country code, e.g. 11912345678
+	Number string
+	// Type is mobile or landline for the brazilian numbers, and PhoneUnknown for the other countries
+	Type PhoneType
+}
+
+// callingCode is a calling code with its region and the lengths of its national numbers
+type callingCode struct {
+	region    string
+	minLength int
+	maxLength int
+}
+
+// callingCodes are the calling codes accepted in the numbers with the country code, with the lengths of the national numbers.
+// The brazilian numbers are checked by their own rules, see parseBrazilianNumber.
+var callingCodes = map[string]callingCode{
+	"1":   {region: "US", minLength: 10, maxLength: 10},
+	"7":   {region: "RU", minLength: 10, maxLength: 10},
+	"20":  {region: "EG", minLength: 9, maxLength: 10},
+	"27":  {region: "ZA", minLength: 9, maxLength: 9},
+	"30":  {region: "GR", minLength: 10, maxLength: 10},
+	"31":  {region: "NL", minLength: 9, maxLength: 9},
+	"32":  {region: "BE", minLength: 8, maxLength: 9},
+	"33":  {region: "FR", minLength: 9, maxLength: 9},
+	"34":  {region: "ES", minLength: 9, maxLength: 9},
+	"36":  {region: "HU", minLength: 8, maxLength: 9},
+	"39":  {region: "IT", minLength: 6, maxLength: 11},
+	"40":  {region: "RO", minLength: 9, maxLength: 9},
+	"41":  {region: "CH", minLength: 9, maxLength: 9},
+	"43":  {region: "AT", minLength: 4, maxLength: 13},
+	"44":  {region: "GB", minLength: 9, maxLength: 10},
+	"45":  {region: "DK", minLength: 8, maxLength: 8},
+	"46":  {region: "SE", minLength: 7, maxLength: 13},
+	"47":  {region: "NO", minLength: 8, maxLength: 8},
+	"48":  {region: "PL", minLength: 9, maxLength: 9},
+	"49":  {region: "DE", minLength: 6, maxLength: 13},
+	"51":  {region: "PE", minLength: 8, maxLength: 9},
+	"52":  {region: "MX", minLength: 10, maxLength: 10},
+	"53":  {region: "CU", minLength: 6, maxLength: 8},
+	"54":  {region: "AR", minLength: 10, maxLength: 11},
+	"55":  {region: PhoneRegionBR, minLength: 10, maxLength: 11},
+	"56":  {region: "CL", minLength: 9, maxLength: 9},
+	"57":  {region: "CO", minLength: 8, maxLength: 10},
+	"58":  {region: "VE", minLength: 10, maxLength: 10},
+	"60":  {region: "MY", minLength: 7, maxLength: 10},
+	"61":  {region: "AU", minLength: 9, maxLength: 9},
+	"62":  {region: "ID", minLength: 7, maxLength: 12},
+	"63":  {region: "PH", minLength: 8, maxLength: 10},
+	"64":  {region: "NZ", minLength: 8, maxLength: 10},
+	"65":  {region: "SG", minLength: 8, maxLength: 8},
+	"66":  {region: "TH", minLength: 8, maxLength: 9},
+	"81":  {region: "JP", minLength: 9, maxLength: 10},
+	"82":  {region: "KR", minLength: 8, maxLength: 10},
+	"84":  {region: "VN", minLength: 9, maxLength: 10},
+	"86":  {region: "CN", minLength: 10, maxLength: 11},
+	"90":  {region: "TR", minLength: 10, maxLength: 10},
+	"91":  {region: "IN", minLength: 10, maxLength: 10},
+	"92":  {region: "PK", minLength: 9, maxLength: 10},
+	"351": {region: "PT", minLength: 9, maxLength: 9},
+	"352": {region: "LU", minLength: 4, maxLength: 11},
+	"353": {region: "IE", minLength: 7, maxLength: 9},
+	"358": {region: "FI", minLength: 5, maxLength: 12},
+	"380": {region: "UA", minLength: 9, maxLength: 9},
+	"420": {region: "CZ", minLength: 9, maxLength: 9},
+	"502": {region: "GT", minLength: 8, maxLength: 8},
+	"503": {region: "SV", minLength: 8, maxLength: 8},
+	"504": {region: "HN", minLength: 8, maxLength: 8},
+	"505": {region: "NI", minLength: 8, maxLength: 8},
+	"506": {region: "CR", minLength: 8, maxLength: 8},
+	"507": {region: "PA", minLength: 7, maxLength: 8},
+	"591": {region: "BO", minLength: 8, maxLength: 8},
+	"593": {region: "EC", minLength: 8, maxLength: 9},
+	"595": {region: "PY", minLength: 9, maxLength: 9},
+	"598": {region: "UY", minLength: 8, maxLength: 8},
+	"966": {region: "SA", minLength: 9, maxLength: 9},
+	"971": {region: "AE", minLength: 8, maxLength: 9},
+	"972": {region: "IL", minLength: 8, maxLength: 9},
+}
+
+// brazilianAreaCodes are the DDD in use by Anatel
+var brazilianAreaCodes = map[string]bool{
+	"11": true, "12": true, "13": true, "14": true, "15": true, "16": true, "17": true, "18": true, "19": true,
+	"21": true, "22": true, "24": true, "27": true, "28": true,
+	"31": true, "32": true, "33": true, "34": true, "35": true, "37": true, "38": true,
+	"41": true, "42": true, "43": true, "44": true, "45": true, "46": true, "47": true, "48": true, "49": true,
+	"51": true, "53": true, "54": true, "55": true,
+	"61": true, "62": true, "63": true, "64": true, "65": true, "66": true, "67": true, "68": true, "69": true,
+	"71": true, "73": true, "74": true, "75": true, "77": true, "79": true,
+	"81": true, "82": true, "83": true, "84": true, "85": true, "86": true, "87": true, "88": true, "89": true,
+	"91": true, "92": true, "93": true, "94": true, "95": true, "96": true, "97": true, "98": true, "99": true,
+}
+
+// phoneMaskReplacer removes the usual mask characters of the phone numbers, e.g. +55 (11) 91234-5678
+var phoneMaskReplacer = strings.NewReplacer(" ", "", "(", "", ")", "", "-", "", ".", "")
+
+// validPhone is the phone tag, which accepts a brazilian number or an international number with the country code.
+// The params restrict the accepted numbers by type and region, e.g. phone=mobile, phone=BR or phone=BR mobile
+func validPhone(fl validator.FieldLevel) bool {
+	phone, ok := ParsePhone(fl.Field().String())
+	if !ok {
+		return false
+	}
+
+	var regions []string
+	var types []PhoneType
+	for _, param := range paramList(fl.Param()) {
+		switch param := PhoneType(strings.ToLower(param)); param {
+		case PhoneMobile, PhoneLandline:
+			types = append(types, param)
+		default:
+			regions = append(regions, strings.ToUpper(string(param)))
+		}
+	}
+
+	return (len(regions) == 0 || slices.Contains(regions, phone.Region)) && (len(types) == 0 || slices.Contains(types, phone.Type))
+}
+
+// ParsePhone parses a phone number, with or without the usual mask. The numbers starting with + or 00 have
+// the country code and are checked by the lengths of the numbers of the country. The other ones are brazilian,
+// with the DDD and optionally the trunk prefix 0, e.g. (11) 91234-5678 or 011 91234-5678.
+// The brazilian numbers should have a valid DDD and be a mobile number, with 9 digits starting with 9,
+// or a landline number, with 8 digits starting with 2 to 5. It returns ok false if the number is not valid.
+func ParsePhone(value string) (phone Phone, ok bool) {
+	digits := phoneMaskReplacer.Replace(strings.TrimSpace(value))
+
+	international := false
+	if rest, found := strings.CutPrefix(digits, "+"); found {
+		digits, international = rest, true
+	} else if rest, found := strings.CutPrefix(digits, "00"); found {
+		digits, international = rest, true
+	}
+
+	if digits == "" || !onlyDigits(digits) {
+		return Phone{}, false
+	}
+
+	if !international {
+		// the brazilian numbers are dialed with the trunk prefix 0 in long distance calls
+		digits = strings.TrimPrefix(digits, "0")
+		return parseBrazilianNumber(digits)
+	}
+
+	for length := 1; length <= 3 && length < len(digits); length++ {
+		code, ok := callingCodes[digits[:length]]
+		if !ok {
+			continue
+		}
+
+		number := digits[length:]
+		if code.region == PhoneRegionBR {
+			return parseBrazilianNumber(number)
+		}
+		if number[0] == '0' || len(number) < code.minLength || len(number) > code.maxLength {
+			return Phone{}, false
+		}
+
+		return Phone{
+			E164:        "+" + digits,
+			CountryCode: digits[:length],
+			Region:      code.region,
+			Number:      number,
+			Type:        PhoneUnknown,
+		}, true
+	}
+
+	return Phone{}, false
+}
+
+// parseBrazilianNumber parses a brazilian number with the DDD and without the country code
+func parseBrazilianNumber(number string) (Phone, bool) {
+	var phoneType PhoneType
+	switch {
+	case len(number) == 11 && number[2] == '9':
+		phoneType = PhoneMobile
+	case len(number) == 10 && number[2] >= '2' && number[2] <= '5':
+		phoneType = PhoneLandline
+	default:
+		return Phone{}, false
+	}
+
+	if !brazilianAreaCodes[number[:2]] {
+		return Phone{}, false
+	}
+
+	return Phone{
+		E164:        "+55" + number,
+		CountryCode: "55",
+		Region:      PhoneRegionBR,
+		AreaCode:    number[:2],
+		Number:      number,
+		Type:        phoneType,
+	}, true
+}
+
+// NormalizePhone returns the E.164 form of the phone number, e.g. (11) 91234-5678 is +5511912345678,
+// or ok false if the number is not valid (see ParsePhone)
+func NormalizePhone(value string) (e164 string, ok bool) {
+	phone, ok := ParsePhone(value)
+	return phone.E164, ok
+}
+
+// normalizePhone is the phone modifier of the normalize tag, the invalid numbers are kept as they are
+// to be reported by the validation
+func normalizePhone(value string) string {
+	if e164, ok := NormalizePhone(value); ok {
+		return e164
+	}
+	return value
+}
@@ -0,0 +1,156 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePhone(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		wantPhone Phone
+		wantOk    bool
+	}{
+		{
+			name:      "brazilian mobile with mask",
+			value:     "(11) 91234-5678",
+			wantPhone: Phone{E164: "+5511912345678", CountryCode: "55", Region: PhoneRegionBR, AreaCode: "11", Number: "11912345678", Type: PhoneMobile},
+			wantOk:    true,
+		},
+		{
+			name:      "brazilian mobile without mask",
+			value:     "11912345678",
+			wantPhone: Phone{E164: "+5511912345678", CountryCode: "55", Region: PhoneRegionBR, AreaCode: "11", Number: "11912345678", Type: PhoneMobile},
+			wantOk:    true,
+		},
+		{
+			name:      "brazilian mobile with country code",
+			value:     "+55 11 91234-5678",
+			wantPhone: Phone{E164: "+5511912345678", CountryCode: "55", Region: PhoneRegionBR, AreaCode: "11", Number: "11912345678", Type: PhoneMobile},
+			wantOk:    true,
+		},
+		{
+			name:      "brazilian landline with trunk prefix",
+			value:     "021 3333-4444",
+			wantPhone: Phone{E164: "+552133334444", CountryCode: "55", Region: PhoneRegionBR, AreaCode: "21", Number: "2133334444", Type: PhoneLandline},
+			wantOk:    true,
+		},
+		{
+			name:      "brazilian number with the international prefix 00",
+			value:     "00 55 55 3222-1234",
+			wantPhone: Phone{E164: "+555532221234", CountryCode: "55", Region: PhoneRegionBR, AreaCode: "55", Number: "5532221234", Type: PhoneLandline},
+			wantOk:    true,
+		},
+		{
+			name:      "us number",
+			value:     "+1 (415) 555-2671",
+			wantPhone: Phone{E164: "+14155552671", CountryCode: "1", Region: "US", Number: "4155552671", Type: PhoneUnknown},
+			wantOk:    true,
+		},
+		{
+			name:      "portuguese number with a 3 digits calling code",
+			value:     "+351 912 345 678",
+			wantPhone: Phone{E164: "+351912345678", CountryCode: "351", Region: "PT", Number: "912345678", Type: PhoneUnknown},
+			wantOk:    true,
+		},
+		{name: "brazilian mobile without the ninth digit", value: "(11) 8123-4567", wantOk: false},
+		{name: "brazilian mobile with 9 digits not starting with 9", value: "11812345678", wantOk: false},
+		{name: "brazilian landline starting with 6", value: "1163334444", wantOk: false},
+		{name: "invalid ddd", value: "(20) 91234-5678", wantOk: false},
+		{name: "ddd starting with 0", value: "+55 01 91234-5678", wantOk: false},
+		{name: "us number too short", value: "+1 415 555 267", wantOk: false},
+		{name: "french number too long", value: "+33 6 12 34 56 789", wantOk: false},
+		{name: "national number starting with 0", value: "+44 020 7946 0958", wantOk: false},
+		{name: "unknown calling code", value: "+999 1234 5678", wantOk: false},
+		{name: "letters", value: "+55 11 9123A-5678", wantOk: false},
+		{name: "only the plus sign", value: "+", wantOk: false},
+		{name: "empty", value: "", wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			phone, ok := ParsePhone(tt.value)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.wantPhone, phone)
+		})
+	}
+}
+
+func TestNormalizePhone(t *testing.T) {
+	tests := []struct {
+		value    string
+		wantE164 string
+		wantOk   bool
+	}{
+		{value: "(11) 91234-5678", wantE164: "+5511912345678", wantOk: true},
+		{value: "+55 (11) 3333.4444", wantE164: "+551133334444", wantOk: true},
+		{value: "0044 20 7946 0958", wantE164: "+442079460958", wantOk: true},
+		{value: "1234", wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			e164, ok := NormalizePhone(tt.value)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.wantE164, e164)
+		})
+	}
+}
+
+func Test_validatorImpl_ValidateStruct_Phone(t *testing.T) {
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	type contact struct {
+		Phone          string `json:"phone" normalize:"phone" validate:"phone"`
+		Mobile         string `json:"mobile" validate:"omitempty,phone=mobile"`
+		BrazilianPhone string `json:"brazilian_phone" validate:"omitempty,phone=BR"`
+		BrazilianOrUS  string `json:"brazilian_or_us" validate:"omitempty,phone=BR0x7CUS"`
+	}
+
+	tests := []struct {
+		name      string
+		dataSet   contact
+		wantField string
+	}{
+		{name: "should accept a brazilian number", dataSet: contact{Phone: "(11) 91234-5678"}},
+		{name: "should accept an international number", dataSet: contact{Phone: "+44 20 7946 0958"}},
+		{name: "should reject an invalid number", dataSet: contact{Phone: "(11) 1234-5678"}, wantField: "phone"},
+		{name: "should accept a mobile", dataSet: contact{Phone: "11912345678", Mobile: "11 91234-5678"}},
+		{name: "should reject a landline when mobile is required", dataSet: contact{Phone: "11912345678", Mobile: "11 3333-4444"}, wantField: "mobile"},
+		{name: "should reject a number of unknown type when mobile is required", dataSet: contact{Phone: "11912345678", Mobile: "+14155552671"}, wantField: "mobile"},
+		{name: "should accept a number of the region", dataSet: contact{Phone: "11912345678", BrazilianPhone: "+55 11 3333-4444"}},
+		{name: "should reject a number of another region", dataSet: contact{Phone: "11912345678", BrazilianPhone: "+14155552671"}, wantField: "brazilian_phone"},
+		{name: "should accept a region of the list", dataSet: contact{Phone: "11912345678", BrazilianOrUS: "+14155552671"}},
+		{name: "should reject a region not in the list", dataSet: contact{Phone: "11912345678", BrazilianOrUS: "+44 20 7946 0958"}, wantField: "brazilian_or_us"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.ValidateStruct(context.Background(), tt.dataSet)
+			if tt.wantField == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "The field '"+tt.wantField+"' should be a valid phone number")
+		})
+	}
+}
+
+func Test_validatorImpl_Normalize_Phone(t *testing.T) {
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	type contact struct {
+		Phone   string `json:"phone" normalize:"phone"`
+		Invalid string `json:"invalid" normalize:"phone"`
+	}
+
+	input := contact{Phone: "(11) 91234-5678", Invalid: "(11) 1234"}
+	assert.NoError(t, v.Normalize(context.Background(), &input))
+	assert.Equal(t, contact{Phone: "+5511912345678", Invalid: "(11) 1234"}, input)
+}
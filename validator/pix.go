@@ -0,0 +1,245 @@
+package validator
+
+import (
+	"net/mail"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/diegoclair/go_utils/validator/brdocs"
+	"github.com/go-playground/validator/v10"
+)
+
+// PixKeyType is the type of a PIX key, as defined by the Banco Central DICT
+type PixKeyType string
+
+// PIX key types
+const (
+	PixKeyCPF   PixKeyType = "cpf"
+	PixKeyCNPJ  PixKeyType = "cnpj"
+	PixKeyEmail PixKeyType = "email"
+	PixKeyPhone PixKeyType = "phone"
+	PixKeyEVP   PixKeyType = "evp"
+)
+
+// pixEmailMaxLength is the max length of an email key in the DICT
+const pixEmailMaxLength = 77
+
+var (
+	pixPhoneRegex = regexp.MustCompile(`^\+55[1-9][0-9]9?[0-9]{8}$`)
+	pixEVPRegex   = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+	// pixPhoneMaskReplacer removes the mask of a phone key, e.g. +55 (11) 91234-5678
+	pixPhoneMaskReplacer = strings.NewReplacer(" ", "", "(", "", ")", "", "-", "")
+)
+
+// DetectPixKeyType returns the type of the PIX key and its normalized form, as registered in the DICT:
+//   - cpf: 11 digits, e.g. 12345678909
+//   - cnpj: 14 characters, numeric or alphanumeric, e.g. 12ABC34501DE35
+//   - email: lowercase, e.g. user@example.com
+//   - phone: E.164 with the +55 country code, e.g. +5511912345678
+//   - evp: the random key, a lowercase UUID
+//
+// The key is accepted with the usual masks. It returns ok false if the key is not valid for any type.
+func DetectPixKeyType(key string) (keyType PixKeyType, normalized string, ok bool) {
+	key = strings.TrimSpace(key)
+
+	if evp := strings.ToLower(key); pixEVPRegex.MatchString(evp) {
+		return PixKeyEVP, evp, true
+	}
+
+	if strings.Contains(key, "@") {
+		email := strings.ToLower(key)
+		address, err := mail.ParseAddress(email)
+		if err != nil || address.Address != email || len(email) > pixEmailMaxLength {
+			return "", "", false
+		}
+		return PixKeyEmail, email, true
+	}
+
+	if strings.HasPrefix(key, "+") {
+		phone := pixPhoneMaskReplacer.Replace(key)
+		if !pixPhoneRegex.MatchString(phone) {
+			return "", "", false
+		}
+		return PixKeyPhone, phone, true
+	}
+
+	document := brdocs.Unformat(key)
+	switch {
+	case brdocs.IsValidCPF(document):
+		return PixKeyCPF, document, true
+	case brdocs.IsValidCNPJ(document):
+		return PixKeyCNPJ, document, true
+	}
+
+	return "", "", false
+}
+
+// pixKeyTypes are the PIX key types, also the names of the tags that go-playground reads as alternatives
+// of the pix_key tag when the types are separated by |, e.g. pix_key=cpf|email
+var pixKeyTypes = map[PixKeyType]bool{PixKeyCPF: true, PixKeyCNPJ: true, PixKeyEmail: true, PixKeyPhone: true, PixKeyEVP: true}
+
+// builtinValidator has only the built-in validations of go-playground, used by the tags replaced by pixKeyTypeTag
+var builtinValidator = validator.New()
+
+// pixKeyGroup is the pix_key tag of the rules of a struct field with the types separated by |
+type pixKeyGroup struct {
+	// types are the allowed types, all of them when empty
+	types []string
+	ok    bool
+}
+
+// pixKeyGroupKey identifies a field of a struct type and the tag with its rules
+type pixKeyGroupKey struct {
+	typ   reflect.Type
+	field string
+	tag   string
+}
+
+// pixKeyGroups caches the pixKeyGroup of the struct fields by pixKeyGroupKey
+var pixKeyGroups sync.Map
+
+// parsePixKeyGroup finds the pix_key tag followed by key types separated by |, e.g. pix_key=cpf|email, in the rules.
+// go-playground reads pix_key=cpf and email as alternative tags, so the types after the first one are other tags.
+// A pix_key tag followed by other tags, e.g. pix_key|uuid, is a usual or of tags and is not a group.
+func parsePixKeyGroup(rules string) pixKeyGroup {
+	for _, rule := range strings.Split(rules, ",") {
+		alternatives := strings.Split(strings.TrimSpace(rule), "|")
+		name, param, _ := strings.Cut(alternatives[0], "=")
+		if name != "pix_key" || len(alternatives) < 2 {
+			continue
+		}
+
+		types := paramList(param)
+		for _, alternative := range alternatives[1:] {
+			if !pixKeyTypes[PixKeyType(alternative)] {
+				return pixKeyGroup{}
+			}
+			types = append(types, alternative)
+		}
+
+		// pix_key without types already allows all the types
+		if param == "" {
+			types = nil
+		}
+		return pixKeyGroup{types: types, ok: true}
+	}
+
+	return pixKeyGroup{}
+}
+
+// escapePixKeyGroups rewrites the pix_key groups of the rules with the escaped pipe, e.g. pix_key=cpf|email
+// to pix_key=cpf0x7Cemail, for the rules that are not read from a struct field, like the ones of Var and ValidateMap
+func escapePixKeyGroups(rules string) string {
+	if !strings.Contains(rules, "pix_key") {
+		return rules
+	}
+
+	parts := strings.Split(rules, ",")
+	for i, rule := range parts {
+		if group := parsePixKeyGroup(rule); group.ok {
+			parts[i] = "pix_key"
+			if len(group.types) > 0 {
+				parts[i] += "=" + strings.Join(group.types, "0x7C")
+			}
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// fieldPixKeyGroup returns the pix_key group of the rules of the struct field being validated,
+// reading the tag of the field, since go-playground gives only the current tag to the validations
+func (v *validatorImpl) fieldPixKeyGroup(fl validator.FieldLevel) pixKeyGroup {
+	parent := reflect.Indirect(fl.Parent())
+	if parent.Kind() != reflect.Struct {
+		return pixKeyGroup{}
+	}
+
+	// the elements of a dive are named by the field and their index, e.g. Keys[2]
+	name, _, _ := strings.Cut(fl.StructFieldName(), "[")
+	key := pixKeyGroupKey{typ: parent.Type(), field: name, tag: v.tagName}
+	if group, ok := pixKeyGroups.Load(key); ok {
+		return group.(pixKeyGroup)
+	}
+
+	var group pixKeyGroup
+	if fld, ok := parent.Type().FieldByName(name); ok {
+		group = parsePixKeyGroup(fld.Tag.Get(v.tagName))
+	}
+	pixKeyGroups.Store(key, group)
+	return group
+}
+
+// validPixKey checks if the key is a valid PIX key of one of the allowed types.
+// The allowed types are separated by spaces or by |, e.g. pix_key=cpf email or pix_key=cpf|email.
+// All the types are allowed when none is given.
+func (v *validatorImpl) validPixKey(fl validator.FieldLevel) bool {
+	keyType, _, ok := DetectPixKeyType(fl.Field().String())
+	if !ok {
+		return false
+	}
+
+	allowed := paramList(fl.Param())
+	if group := v.fieldPixKeyGroup(fl); group.ok {
+		allowed = group.types
+	}
+	if len(allowed) == 0 {
+		return true
+	}
+
+	for _, t := range allowed {
+		if PixKeyType(t) == keyType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// pixKeyTypeTag returns the validation of a tag named as a PIX key type, e.g. email, which is fn but fails
+// when the tag is an alternative of a pix_key group, e.g. pix_key=cpf|email, so the group is valid only when
+// the pix_key tag accepts the key, with the rules of the DICT
+func (v *validatorImpl) pixKeyTypeTag(fn validator.Func) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		if v.fieldPixKeyGroup(fl).ok {
+			return false
+		}
+		return fn(fl)
+	}
+}
+
+// pixKeyTypeValidations are the validations of the tags named as the PIX key types without a pix_key group.
+// cpf, cnpj and phone are the tags of the package, email is the one of go-playground and evp is the random key.
+func (v *validatorImpl) pixKeyTypeValidations() map[PixKeyType]validator.Func {
+	return map[PixKeyType]validator.Func{
+		PixKeyCPF:  validCPF,
+		PixKeyCNPJ: validCNPJ,
+		PixKeyEmail: func(fl validator.FieldLevel) bool {
+			return builtinValidator.Var(fl.Field().Interface(), "email") == nil
+		},
+		PixKeyPhone: validPhone,
+		PixKeyEVP: func(fl validator.FieldLevel) bool {
+			keyType, _, ok := DetectPixKeyType(fl.Field().String())
+			return ok && keyType == PixKeyEVP
+		},
+	}
+}
+
+// pixKeyFieldTag returns the tag and the param of a failed pix_key group, e.g. pix_key and cpf|email,
+// since go-playground reports the alternatives that failed as the tag, e.g. pix_key=cpf|email
+func pixKeyFieldTag(tag, param string) (string, string) {
+	if group := parsePixKeyGroup(tag); group.ok {
+		return "pix_key", strings.Join(group.types, "|")
+	}
+	return tag, param
+}
+
+// paramList splits a param with a list of values separated by spaces or by | (written as 0x7C in the tag,
+// since | is the or operator of the tags), e.g. cpf email or cpf0x7Cemail
+func paramList(param string) []string {
+	return strings.FieldsFunc(param, func(r rune) bool {
+		return r == ' ' || r == '|'
+	})
+}
@@ -0,0 +1,168 @@
+package validator
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectPixKeyType(t *testing.T) {
+	tests := []struct {
+		name           string
+		key            string
+		wantType       PixKeyType
+		wantNormalized string
+		wantOk         bool
+	}{
+		{name: "cpf", key: "12345678909", wantType: PixKeyCPF, wantNormalized: "12345678909", wantOk: true},
+		{name: "cpf with mask", key: "123.456.789-09", wantType: PixKeyCPF, wantNormalized: "12345678909", wantOk: true},
+		{name: "cpf with wrong check digit", key: "12345678900", wantOk: false},
+		{name: "cpf with repeated digits", key: "11111111111", wantOk: false},
+		{name: "cnpj", key: "22.796.729/0001-59", wantType: PixKeyCNPJ, wantNormalized: "22796729000159", wantOk: true},
+		{name: "alphanumeric cnpj", key: "12.abc.345/01de-35", wantType: PixKeyCNPJ, wantNormalized: "12ABC34501DE35", wantOk: true},
+		{name: "cnpj with wrong check digit", key: "22796729000158", wantOk: false},
+		{name: "email", key: " User@Example.com ", wantType: PixKeyEmail, wantNormalized: "user@example.com", wantOk: true},
+		{name: "email with display name", key: "User <user@example.com>", wantOk: false},
+		{name: "email without domain", key: "user@", wantOk: false},
+		{name: "mobile phone", key: "+5511912345678", wantType: PixKeyPhone, wantNormalized: "+5511912345678", wantOk: true},
+		{name: "landline phone", key: "+551133334444", wantType: PixKeyPhone, wantNormalized: "+551133334444", wantOk: true},
+		{name: "phone with mask", key: "+55 (11) 91234-5678", wantType: PixKeyPhone, wantNormalized: "+5511912345678", wantOk: true},
+		{name: "phone from another country", key: "+14155552671", wantOk: false},
+		{name: "phone without country code", key: "+11912345678", wantOk: false},
+		{name: "evp", key: "123e4567-e89b-42d3-a456-426614174000", wantType: PixKeyEVP, wantNormalized: "123e4567-e89b-42d3-a456-426614174000", wantOk: true},
+		{name: "evp in uppercase", key: "123E4567-E89B-42D3-A456-426614174000", wantType: PixKeyEVP, wantNormalized: "123e4567-e89b-42d3-a456-426614174000", wantOk: true},
+		{name: "evp without dashes", key: "123e4567e89b42d3a456426614174000", wantOk: false},
+		{name: "empty", key: "", wantOk: false},
+		{name: "random text", key: "my key", wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keyType, normalized, ok := DetectPixKeyType(tt.key)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.wantType, keyType)
+			assert.Equal(t, tt.wantNormalized, normalized)
+		})
+	}
+}
+
+func Test_validatorImpl_ValidateStruct_PixKey(t *testing.T) {
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	type payment struct {
+		Key           string `json:"key" validate:"pix_key"`
+		DocumentKey   string `json:"document_key" validate:"omitempty,pix_key=cpf cnpj"`
+		CPFOrEmailKey string `json:"cpf_or_email_key" validate:"omitempty,pix_key=cpf0x7Cemail"`
+	}
+
+	tests := []struct {
+		name      string
+		dataSet   payment
+		wantField string
+	}{
+		{name: "should accept any key type", dataSet: payment{Key: "+5511912345678"}},
+		{name: "should reject an invalid key", dataSet: payment{Key: "invalid"}, wantField: "key"},
+		{name: "should accept an allowed type", dataSet: payment{Key: "user@example.com", DocumentKey: "12345678909"}},
+		{name: "should reject a type not allowed", dataSet: payment{Key: "user@example.com", DocumentKey: "user@example.com"}, wantField: "document_key"},
+		{name: "should accept types separated by escaped pipe", dataSet: payment{Key: "user@example.com", CPFOrEmailKey: "user@example.com"}},
+		{name: "should reject types not in the escaped pipe list", dataSet: payment{Key: "user@example.com", CPFOrEmailKey: "+5511912345678"}, wantField: "cpf_or_email_key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.ValidateStruct(context.Background(), tt.dataSet)
+			if tt.wantField == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), "The field '"+tt.wantField+"' should be a valid pix key")
+		})
+	}
+}
+
+func Test_validatorImpl_ValidateStruct_PixKeyPipe(t *testing.T) {
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	type payment struct {
+		CPFOrEmailKey string   `json:"cpf_or_email_key" validate:"omitempty,pix_key=cpf|email"`
+		RandomKey     string   `json:"random_key" validate:"omitempty,pix_key=cpf|evp"`
+		Keys          []string `json:"keys" validate:"dive,pix_key=cnpj|phone"`
+		Email         string   `json:"email" validate:"omitempty,email"`
+		Phone         string   `json:"phone" validate:"omitempty,phone"`
+	}
+
+	// an email longer than the 77 characters of the DICT is valid for the email tag, but not as a pix key
+	longEmail := strings.Repeat("a", 70) + "@example.com"
+
+	tests := []struct {
+		name    string
+		dataSet payment
+		wantErr []FieldError
+	}{
+		{name: "should accept the types of the pipe list", dataSet: payment{CPFOrEmailKey: "user@example.com", RandomKey: "123e4567-e89b-12d3-a456-426614174000", Keys: []string{"11.222.333/0001-81", "+5511912345678"}}},
+		{name: "should accept the first type of the pipe list", dataSet: payment{CPFOrEmailKey: "529.982.247-25", RandomKey: "529.982.247-25"}},
+		{
+			name:    "should reject an email that is not a pix key",
+			dataSet: payment{CPFOrEmailKey: longEmail},
+			wantErr: []FieldError{{Field: "cpf_or_email_key", Tag: "pix_key", Param: "cpf|email", Value: RedactedValue, Message: "The field 'cpf_or_email_key' should be a valid pix key"}},
+		},
+		{
+			name:    "should reject a type not in the pipe list",
+			dataSet: payment{RandomKey: "user@example.com"},
+			wantErr: []FieldError{{Field: "random_key", Tag: "pix_key", Param: "cpf|evp", Value: RedactedValue, Message: "The field 'random_key' should be a valid pix key"}},
+		},
+		{
+			name:    "should reject a phone that is not a pix key in a dive",
+			dataSet: payment{Keys: []string{"(11) 91234-5678"}},
+			wantErr: []FieldError{{Field: "keys[0]", Tag: "pix_key", Param: "cnpj|phone", Value: RedactedValue, Message: "The field 'keys[0]' should be a valid pix key"}},
+		},
+		{name: "should keep the email and phone tags outside a pix key", dataSet: payment{Email: longEmail, Phone: "(11) 91234-5678"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.ValidateStruct(context.Background(), tt.dataSet)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tt.wantErr, FieldErrors(err))
+		})
+	}
+
+	t.Run("should read the pipe list in Var and ValidateMap", func(t *testing.T) {
+		assert.NoError(t, v.Var("user@example.com", "pix_key=cpf|email"))
+		assert.Error(t, v.Var(longEmail, "pix_key=cpf|email"))
+		assert.Error(t, v.Var("+5511912345678", "pix_key=cpf|evp"))
+
+		err := v.ValidateMap(context.Background(), map[string]any{"key": longEmail}, map[string]any{"key": "pix_key=cpf|email"})
+		assert.Equal(t, []FieldError{
+			{Field: "key", Tag: "pix_key", Param: "cpf|email", Value: RedactedValue, Message: "The field 'key' should be a valid pix key"},
+		}, FieldErrors(err))
+	})
+}
+
+func Test_parsePixKeyGroup(t *testing.T) {
+	tests := []struct {
+		rules string
+		want  pixKeyGroup
+	}{
+		{rules: "required,pix_key=cpf|email", want: pixKeyGroup{types: []string{"cpf", "email"}, ok: true}},
+		{rules: "pix_key=cpf email|evp|phone", want: pixKeyGroup{types: []string{"cpf", "email", "evp", "phone"}, ok: true}},
+		{rules: "pix_key|cpf", want: pixKeyGroup{ok: true}},
+		{rules: "pix_key=cpf", want: pixKeyGroup{}},
+		{rules: "pix_key=cpf|uuid", want: pixKeyGroup{}},
+		{rules: "email|pix_key=cpf", want: pixKeyGroup{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rules, func(t *testing.T) {
+			assert.Equal(t, tt.want, parsePixKeyGroup(tt.rules))
+		})
+	}
+}
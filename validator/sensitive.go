@@ -0,0 +1,118 @@
+package validator
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// RedactedValue replaces the value of the sensitive fields in FieldError.Value and in the {value} placeholder
+const RedactedValue = "***"
+
+// sensitiveMarker is the tag that marks a field as sensitive, e.g. `validate:"required,sensitive"`. It never fails.
+const sensitiveMarker = "sensitive"
+
+var (
+	// sensitiveTagsMu guards sensitiveTags, which can be extended with RegisterSensitiveTags
+	sensitiveTagsMu sync.RWMutex
+
+	// sensitiveTags are the tags of the fields that hold personal data or secrets,
+	// the values of the fields with any of them are redacted from the errors
+	sensitiveTags = map[string]bool{
+		sensitiveMarker:  true,
+		"password":       true,
+		"cpf":            true,
+		"cnpj":           true,
+		"cnh":            true,
+		"pis":            true,
+		"cns":            true,
+		"titulo_eleitor": true,
+		"renavam":        true,
+		"pix_key":        true,
+		"bank_account":   true,
+		"credit_card":    true,
+		"jwt":            true,
+	}
+)
+
+// RegisterSensitiveTags adds tags to the list of sensitive tags, e.g. RegisterSensitiveTags("card_token").
+// The values of the fields with a sensitive tag in their rules are redacted from the errors,
+// whatever is the tag that failed. Use the sensitive tag to mark a single field.
+func RegisterSensitiveTags(tags ...string) {
+	sensitiveTagsMu.Lock()
+	defer sensitiveTagsMu.Unlock()
+
+	for _, tag := range tags {
+		sensitiveTags[tag] = true
+	}
+}
+
+// isSensitiveTag reports whether the tag is in the list of sensitive tags
+func isSensitiveTag(tag string) bool {
+	sensitiveTagsMu.RLock()
+	defer sensitiveTagsMu.RUnlock()
+	return sensitiveTags[tag]
+}
+
+// hasSensitiveTag reports whether any of the rules of the tag, including the ones after dive
+// and the alternatives of the or operator, is a sensitive tag
+func hasSensitiveTag(rules string) bool {
+	for _, rule := range strings.Split(rules, ",") {
+		for _, alternative := range strings.Split(rule, "|") {
+			name, _, _ := strings.Cut(strings.TrimSpace(alternative), "=")
+			if isSensitiveTag(name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isSensitiveField reports whether the tag that failed is sensitive or the field has a sensitive tag in its rules.
+// parent is the struct that holds the field, whose rules are read from the tag of the validator.
+func (v *validatorImpl) isSensitiveField(parent reflect.Type, err validator.FieldError) bool {
+	if isSensitiveTag(err.Tag()) {
+		return true
+	}
+
+	if parent != nil && parent.Kind() == reflect.Struct {
+		// the fields reported by the struct rules are named by their path, e.g. Items[2].Sku
+		segments := splitNamespace(err.StructField())
+		name, _, _ := strings.Cut(segments[len(segments)-1], "[")
+		if fld, ok := parent.FieldByName(name); ok {
+			return hasSensitiveTag(fld.Tag.Get(v.tagName))
+		}
+	}
+
+	return false
+}
+
+// fieldErrorValue returns the value reported in the FieldError: RedactedValue when the field is sensitive and not empty,
+// the value when it is a non empty string, a number or a boolean, and nil for the other kinds, e.g. structs and slices.
+func fieldErrorValue(value any, sensitive bool) any {
+	reflectValue := reflect.ValueOf(value)
+
+	// an empty value, e.g. of a required field, tells nothing and is not redacted
+	if sensitive {
+		if !reflectValue.IsValid() || reflectValue.IsZero() {
+			return nil
+		}
+		return RedactedValue
+	}
+
+	switch reflectValue.Kind() {
+	case reflect.String:
+		if reflectValue.Len() > 0 {
+			return value
+		}
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return value
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validatorImpl_ValidateStruct_Sensitive(t *testing.T) {
+	RegisterSensitiveTags("card_token")
+
+	cardToken := func(fl validator.FieldLevel) bool {
+		return len(fl.Field().String()) == 16
+	}
+
+	v, err := NewValidator(WithCustomValidation("card_token", cardToken))
+	assert.NoError(t, err)
+	v.RegisterMessage(LocaleEN, "email", "The field '{field}' has the invalid email {value}")
+	v.RegisterMessage(LocaleEN, "min", "The field '{field}' has {value}, the minimum is {param}")
+
+	type customer struct {
+		Email    string   `json:"email" validate:"email"`
+		Secret   string   `json:"secret" validate:"omitempty,email,sensitive"`
+		Document string   `json:"document" validate:"omitempty,len=11,cpf"`
+		Card     string   `json:"card" validate:"omitempty,card_token"`
+		Age      int      `json:"age" validate:"min=18"`
+		Phones   []string `json:"phones" validate:"dive,min=10,sensitive"`
+	}
+
+	err = v.ValidateStruct(context.Background(), customer{
+		Email:    "john",
+		Secret:   "hunter2",
+		Document: "123456",
+		Card:     "4111",
+		Age:      17,
+		Phones:   []string{"11912345678", "119123"},
+	})
+
+	assert.Equal(t, []FieldError{
+		{Field: "email", Tag: "email", Value: "john", Message: "The field 'email' has the invalid email john"},
+		{Field: "secret", Tag: "email", Value: RedactedValue, Message: "The field 'secret' has the invalid email ***"},
+		{Field: "document", Tag: "len", Param: "11", Value: RedactedValue, Message: "The field 'document' should have the length or value: 11"},
+		{Field: "card", Tag: "card_token", Value: RedactedValue, Message: "The field 'card' is invalid."},
+		{Field: "age", Tag: "min", Param: "18", Value: 17, Message: "The field 'age' has 17, the minimum is 18"},
+		{Field: "phones[1]", Tag: "min", Param: "10", Value: RedactedValue, Message: "The field 'phones[1]' has ***, the minimum is 10"},
+	}, FieldErrors(err))
+
+	for _, raw := range []string{"hunter2", "123456", "4111", "119123"} {
+		assert.NotContains(t, err.Error(), raw)
+
+		causes, marshalErr := json.Marshal(FieldErrors(err))
+		assert.NoError(t, marshalErr)
+		assert.NotContains(t, string(causes), raw)
+	}
+}
+
+func Test_hasSensitiveTag(t *testing.T) {
+	assert.True(t, hasSensitiveTag("required,cpf"))
+	assert.True(t, hasSensitiveTag("omitempty,cpf|cnpj"))
+	assert.True(t, hasSensitiveTag("dive,sensitive"))
+	assert.True(t, hasSensitiveTag("required,password=Login"))
+	assert.False(t, hasSensitiveTag("required,email"))
+	assert.False(t, hasSensitiveTag(""))
+}
@@ -0,0 +1,98 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/diegoclair/go_utils/resterrors"
+)
+
+// RowError are the field errors of an item of the slice validated by ValidateSlice
+type RowError struct {
+	// Row is the index of the item in the slice, starting at 0
+	Row int `json:"row"`
+	// Errors are the invalid fields of the item, named by their path in the slice, e.g. [17].cpf.
+	// A nil item is reported as required, named by its index, e.g. [17].
+	Errors []FieldError `json:"errors"`
+}
+
+func (v *validatorImpl) ValidateSlice(ctx context.Context, items any) error {
+	value := reflect.Indirect(reflect.ValueOf(items))
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return resterrors.NewInternalServerError(fmt.Sprintf("Invalid argument passed to slice: expected a slice, got %T", items))
+	}
+
+	ctx, cancel := v.budgetContext(ctx)
+	defer cancel()
+
+	var (
+		rowErrors []RowError
+		total     int
+	)
+	for i := 0; i < value.Len(); i++ {
+		prefix := "[" + strconv.Itoa(i) + "]"
+
+		var fieldErrors []FieldError
+		if item := value.Index(i); (item.Kind() == reflect.Ptr || item.Kind() == reflect.Interface) && item.IsNil() {
+			fieldErrors = []FieldError{{Field: prefix, Tag: "required", Message: v.messages.Message(v.locale(ctx), "required", prefix, "")}}
+		} else {
+			var err error
+			fieldErrors, err = v.structFieldErrors(ctx, item.Interface(), prefix+".", nil)
+			if err != nil {
+				return err
+			}
+		}
+		if len(fieldErrors) == 0 {
+			continue
+		}
+
+		// the items after the limit of errors are not validated
+		fieldErrors = v.limitFieldErrors(fieldErrors)
+		if v.maxErrors > 0 {
+			fieldErrors = fieldErrors[:min(len(fieldErrors), v.maxErrors-total)]
+		}
+
+		rowErrors = append(rowErrors, RowError{Row: i, Errors: fieldErrors})
+		total += len(fieldErrors)
+
+		if v.failFast || (v.maxErrors > 0 && total >= v.maxErrors) {
+			break
+		}
+	}
+
+	if len(rowErrors) == 0 {
+		return nil
+	}
+
+	return resterrors.NewUnprocessableEntity("Invalid input data", rowErrors)
+}
+
+// RowErrors returns the list of RowError of an error returned by ValidateSlice,
+// or nil when the error has no row errors.
+func RowErrors(err error) []RowError {
+	var restErr resterrors.RestErr
+	if !errors.As(err, &restErr) {
+		return nil
+	}
+
+	causes, _ := restErr.Causes().([]any)
+	for _, cause := range causes {
+		if rowErrors, ok := cause.([]RowError); ok {
+			return rowErrors
+		}
+	}
+
+	return nil
+}
+
+// flattenRowErrors returns the field errors of all the rows, which have the index of the row in the path, e.g. [17].cpf
+func flattenRowErrors(rowErrors []RowError) []FieldError {
+	var fieldErrors []FieldError
+	for _, row := range rowErrors {
+		fieldErrors = append(fieldErrors, row.Errors...)
+	}
+	return fieldErrors
+}
@@ -0,0 +1,169 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+)
+
+type sliceRow struct {
+	Name string `json:"name" validate:"required"`
+	CPF  string `json:"cpf" validate:"required,cpf"`
+}
+
+func Test_validatorImpl_ValidateSlice(t *testing.T) {
+	rows := []sliceRow{
+		{Name: "John", CPF: "529.982.247-25"},
+		{Name: "", CPF: "123"},
+		{Name: "Mary", CPF: "529.982.247-25"},
+		{Name: "Ana", CPF: "111.111.111-11"},
+	}
+
+	tests := []struct {
+		name          string
+		opts          []Option
+		items         any
+		wantRowErrors []RowError
+	}{
+		{
+			name:  "valid rows",
+			items: rows[:1],
+		},
+		{
+			name:  "errors indexed by row",
+			items: rows,
+			wantRowErrors: []RowError{
+				{Row: 1, Errors: []FieldError{
+					{Field: "[1].name", Tag: "required", Message: "The field '[1].name' is required"},
+					{Field: "[1].cpf", Tag: "cpf", Value: RedactedValue, Message: "The field '[1].cpf' should be a valid cpf"},
+				}},
+				{Row: 3, Errors: []FieldError{
+					{Field: "[3].cpf", Tag: "cpf", Value: RedactedValue, Message: "The field '[3].cpf' should be a valid cpf"},
+				}},
+			},
+		},
+		{
+			name:  "pointers to the rows",
+			items: []*sliceRow{&rows[0], &rows[3]},
+			wantRowErrors: []RowError{
+				{Row: 1, Errors: []FieldError{
+					{Field: "[1].cpf", Tag: "cpf", Value: RedactedValue, Message: "The field '[1].cpf' should be a valid cpf"},
+				}},
+			},
+		},
+		{
+			name:  "nil rows are required",
+			items: []*sliceRow{&rows[0], nil},
+			wantRowErrors: []RowError{
+				{Row: 1, Errors: []FieldError{
+					{Field: "[1]", Tag: "required", Message: "The field '[1]' is required"},
+				}},
+			},
+		},
+		{
+			name:  "fail fast stops at the first error",
+			opts:  []Option{WithFailFast()},
+			items: rows,
+			wantRowErrors: []RowError{
+				{Row: 1, Errors: []FieldError{
+					{Field: "[1].name", Tag: "required", Message: "The field '[1].name' is required"},
+				}},
+			},
+		},
+		{
+			name:  "max errors stops when the limit is reached",
+			opts:  []Option{WithMaxErrors(1)},
+			items: rows,
+			wantRowErrors: []RowError{
+				{Row: 1, Errors: []FieldError{
+					{Field: "[1].name", Tag: "required", Message: "The field '[1].name' is required"},
+				}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := NewValidator(tt.opts...)
+			assert.NoError(t, err)
+
+			err = v.ValidateSlice(context.Background(), tt.items)
+			if tt.wantRowErrors == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			var restErr resterrors.RestErr
+			assert.ErrorAs(t, err, &restErr)
+			assert.Equal(t, http.StatusUnprocessableEntity, restErr.StatusCode())
+			assert.Equal(t, tt.wantRowErrors, RowErrors(err))
+		})
+	}
+}
+
+func Test_validatorImpl_ValidateSlice_stopsValidating(t *testing.T) {
+	var validated int
+	counter := func(fl validator.FieldLevel) bool {
+		validated++
+		return fl.Field().String() != ""
+	}
+
+	v, err := NewValidator(WithFailFast(), WithCustomValidation("counted", counter))
+	assert.NoError(t, err)
+
+	type row struct {
+		Code string `json:"code" validate:"counted"`
+	}
+
+	err = v.ValidateSlice(context.Background(), []row{{Code: "a"}, {}, {Code: "c"}, {Code: "d"}})
+	assert.Error(t, err)
+	assert.Equal(t, 2, validated)
+}
+
+func Test_validatorImpl_ValidateSlice_errors(t *testing.T) {
+	errDatabase := errors.New("database is down")
+
+	v, err := NewValidator()
+	assert.NoError(t, err)
+	assert.NoError(t, v.RegisterValidationCtx("available", func(ctx context.Context, fl validator.FieldLevel) (bool, error) {
+		return false, errDatabase
+	}))
+
+	type row struct {
+		Code string `json:"code" validate:"available"`
+	}
+
+	tests := []struct {
+		name  string
+		items any
+	}{
+		{name: "not a slice", items: row{}},
+		{name: "validation error", items: []row{{Code: "a"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.ValidateSlice(context.Background(), tt.items)
+
+			var restErr resterrors.RestErr
+			assert.ErrorAs(t, err, &restErr)
+			assert.Equal(t, http.StatusInternalServerError, restErr.StatusCode())
+		})
+	}
+}
+
+func TestFieldErrors_rows(t *testing.T) {
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	err = v.ValidateSlice(context.Background(), []sliceRow{{Name: "John", CPF: "529.982.247-25"}, {CPF: "529.982.247-25"}})
+	assert.Equal(t, []FieldError{
+		{Field: "[1].name", Tag: "required", Message: "The field '[1].name' is required"},
+	}, FieldErrors(err))
+	assert.Nil(t, RowErrors(errors.New("other error")))
+}
@@ -0,0 +1,142 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/go-playground/validator/v10"
+)
+
+// StructRuleFunc is a rule that involves several fields of a struct, e.g. either cpf or cnpj is required,
+// or end_date is after start_date. It reports each violation with report, the value should not be changed.
+type StructRuleFunc[T any] func(ctx context.Context, value *T, report StructRuleReport)
+
+// StructRuleReport attaches a violation of a struct rule to a field, named by its Go path from the struct,
+// e.g. EndDate or Items[2].Sku, with a code and its param. The violation is reported as a FieldError
+// with the code as tag and the message of the code, so the codes of the catalog can be reused,
+// e.g. report("CNPJ", "required", "") or report("EndDate", "gtfield", "StartDate"),
+// and the new ones need a message registered with RegisterMessage.
+type StructRuleReport func(field, code, param string)
+
+// structRule runs a rule of a struct for the struct level of go-playground
+type structRule func(ctx context.Context, sl validator.StructLevel)
+
+// RegisterStructRule adds a rule to the structs of type T, checked by ValidateStruct, ValidatePatch and Bind
+// after the tags of their fields, including the structs nested in other ones. A type can have several rules.
+// It returns an error when v was not created by NewValidator, e.g. a mock, or T is not a struct.
+//
+// The rules of a type that already has one can be added while other goroutines validate. The first rule of a type
+// registers it in go-playground, which is not safe during the validation, so like RegisterValidation
+// it should be called before the validator is used.
+func RegisterStructRule[T any](v Validator, fn StructRuleFunc[T]) error {
+	impl, ok := v.(*validatorImpl)
+	if !ok {
+		return resterrors.NewInternalServerError("Error trying to register struct rule", fmt.Errorf("unsupported validator %T", v))
+	}
+
+	typ := reflect.TypeFor[T]()
+	if typ.Kind() != reflect.Struct {
+		return resterrors.NewInternalServerError("Error trying to register struct rule", fmt.Errorf("%s is not a struct", typ))
+	}
+
+	impl.addStructRule(typ, func(ctx context.Context, sl validator.StructLevel) {
+		current := sl.Current()
+
+		var value *T
+		if current.CanAddr() {
+			value = current.Addr().Interface().(*T)
+		} else {
+			copied := current.Interface().(T)
+			value = &copied
+		}
+
+		fn(ctx, value, func(field, code, param string) {
+			sl.ReportError(structPathValue(current, field), field, field, code, param)
+		})
+	})
+
+	return nil
+}
+
+// addStructRule adds the rule of the type, registering the struct level validation of the type on its first rule,
+// since go-playground keeps only one validation per type
+func (v *validatorImpl) addStructRule(typ reflect.Type, rule structRule) {
+	v.structRulesMu.Lock()
+	defer v.structRulesMu.Unlock()
+
+	if _, ok := v.structRules[typ]; !ok {
+		v.validator.RegisterStructValidationCtx(v.runStructRules, reflect.Zero(typ).Interface())
+	}
+	v.structRules[typ] = append(v.structRules[typ], rule)
+}
+
+// runStructRules runs the rules of the type of the struct being validated
+func (v *validatorImpl) runStructRules(ctx context.Context, sl validator.StructLevel) {
+	// after the budget or the request deadline the remaining rules are skipped
+	if err := ctx.Err(); err != nil {
+		if state := validationStateFromContext(ctx); state != nil {
+			state.addError(err)
+		}
+		return
+	}
+
+	v.structRulesMu.RLock()
+	rules := v.structRules[sl.Current().Type()]
+	v.structRulesMu.RUnlock()
+
+	for _, rule := range rules {
+		rule(ctx, sl)
+	}
+}
+
+// structPathValue returns the value of the field at the Go path from the struct, e.g. Items[2].Sku,
+// or nil when the path does not exist, so the value reported in the error is the one of the field
+func structPathValue(value reflect.Value, path string) any {
+	for _, segment := range splitNamespace(path) {
+		name, indexes, _ := strings.Cut(segment, "[")
+
+		value = reflect.Indirect(value)
+		if value.Kind() != reflect.Struct {
+			return nil
+		}
+		value = value.FieldByName(name)
+		if !value.IsValid() {
+			return nil
+		}
+
+		for indexes != "" {
+			var index string
+			index, indexes, _ = strings.Cut(indexes, "]")
+			indexes = strings.TrimPrefix(indexes, "[")
+
+			value = reflect.Indirect(value)
+			switch value.Kind() {
+			case reflect.Slice, reflect.Array:
+				i, err := strconv.Atoi(index)
+				if err != nil || i < 0 || i >= value.Len() {
+					return nil
+				}
+				value = value.Index(i)
+			case reflect.Map:
+				if value.Type().Key().Kind() != reflect.String {
+					return nil
+				}
+				value = value.MapIndex(reflect.ValueOf(index).Convert(value.Type().Key()))
+				if !value.IsValid() {
+					return nil
+				}
+			default:
+				return nil
+			}
+		}
+	}
+
+	if !value.IsValid() || !value.CanInterface() {
+		return nil
+	}
+	return value.Interface()
+}
@@ -0,0 +1,212 @@
+package validator
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type structRulePerson struct {
+	PersonType string `json:"person_type" validate:"required,oneof=PF PJ"`
+	CPF        string `json:"cpf" validate:"omitempty,cpf"`
+	CNPJ       string `json:"cnpj" validate:"omitempty,cnpj"`
+}
+
+type structRuleItem struct {
+	Sku      string `json:"sku" validate:"required"`
+	Quantity int    `json:"quantity"`
+}
+
+type structRuleOrder struct {
+	StartDate time.Time        `json:"start_date"`
+	EndDate   time.Time        `json:"end_date"`
+	Buyer     structRulePerson `json:"buyer"`
+	Items     []structRuleItem `json:"items" validate:"dive"`
+}
+
+func Test_RegisterStructRule(t *testing.T) {
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	v.RegisterMessage(LocaleEN, "min_quantity", "The field '{field}' should be at least {param} for the sku {value}")
+
+	assert.NoError(t, RegisterStructRule(v, func(ctx context.Context, person *structRulePerson, report StructRuleReport) {
+		switch {
+		case person.PersonType == "PJ" && person.CNPJ == "":
+			report("CNPJ", "required", "")
+		case person.PersonType == "PF" && person.CPF == "":
+			report("CPF", "required", "")
+		}
+	}))
+	assert.NoError(t, RegisterStructRule(v, func(ctx context.Context, order *structRuleOrder, report StructRuleReport) {
+		if !order.EndDate.After(order.StartDate) {
+			report("EndDate", "gtfield", "StartDate")
+		}
+	}))
+	assert.NoError(t, RegisterStructRule(v, func(ctx context.Context, order *structRuleOrder, report StructRuleReport) {
+		for i, item := range order.Items {
+			if item.Quantity < 1 {
+				report("Items["+strconv.Itoa(i)+"].Sku", "min_quantity", "1")
+			}
+		}
+	}))
+
+	start := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	validOrder := func() structRuleOrder {
+		return structRuleOrder{
+			StartDate: start,
+			EndDate:   start.AddDate(0, 0, 1),
+			Buyer:     structRulePerson{PersonType: "PF", CPF: "529.982.247-25"},
+			Items:     []structRuleItem{{Sku: "A1", Quantity: 1}},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		order   func() structRuleOrder
+		wantErr []FieldError
+	}{
+		{
+			name:  "valid",
+			order: validOrder,
+		},
+		{
+			name: "rule of a nested struct reuses the message of the tag",
+			order: func() structRuleOrder {
+				order := validOrder()
+				order.Buyer = structRulePerson{PersonType: "PJ"}
+				return order
+			},
+			wantErr: []FieldError{
+				{Field: "buyer.cnpj", Tag: "required", Message: "The field 'buyer.cnpj' is required"},
+			},
+		},
+		{
+			name: "several rules of the same type and the param renamed to the client field",
+			order: func() structRuleOrder {
+				order := validOrder()
+				order.EndDate = start
+				order.Items = []structRuleItem{{Sku: "A1", Quantity: 1}, {Sku: "B2"}}
+				return order
+			},
+			wantErr: []FieldError{
+				{Field: "end_date", Tag: "gtfield", Param: "start_date", Message: "The field 'end_date' should be greater than the field start_date"},
+				{Field: "items[1].sku", Tag: "min_quantity", Param: "1", Value: "B2", Message: "The field 'items[1].sku' should be at least 1 for the sku B2"},
+			},
+		},
+		{
+			name: "rules run after the tags of the fields",
+			order: func() structRuleOrder {
+				order := validOrder()
+				order.Buyer = structRulePerson{PersonType: "PF", CNPJ: "123"}
+				return order
+			},
+			wantErr: []FieldError{
+				{Field: "buyer.cnpj", Tag: "cnpj", Value: RedactedValue, Message: "The field 'buyer.cnpj' should be a valid cnpj"},
+				{Field: "buyer.cpf", Tag: "required", Message: "The field 'buyer.cpf' is required"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := tt.order()
+			err := v.ValidateStruct(context.Background(), &order)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			var restErr resterrors.RestErr
+			assert.ErrorAs(t, err, &restErr)
+			assert.Equal(t, http.StatusUnprocessableEntity, restErr.StatusCode())
+			assert.Equal(t, tt.wantErr, FieldErrors(err))
+		})
+	}
+}
+
+func Test_RegisterStructRule_sensitive(t *testing.T) {
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	assert.NoError(t, RegisterStructRule(v, func(ctx context.Context, order *structRuleOrder, report StructRuleReport) {
+		if order.Buyer.CPF != "" && order.Buyer.CNPJ != "" {
+			report("Buyer.CPF", "excluded_with", "CNPJ")
+		}
+	}))
+
+	order := structRuleOrder{
+		EndDate: time.Now(),
+		Buyer:   structRulePerson{PersonType: "PF", CPF: "529.982.247-25", CNPJ: "11.222.333/0001-81"},
+	}
+	fieldErrors := FieldErrors(v.ValidateStruct(context.Background(), order))
+	if assert.Len(t, fieldErrors, 1) {
+		assert.Equal(t, "buyer.cpf", fieldErrors[0].Field)
+		assert.Equal(t, RedactedValue, fieldErrors[0].Value)
+	}
+}
+
+func Test_RegisterStructRule_concurrent(t *testing.T) {
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	noop := func(ctx context.Context, item *structRuleItem, report StructRuleReport) {}
+	assert.NoError(t, RegisterStructRule(v, noop))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, v.ValidateStruct(context.Background(), structRuleItem{Sku: "A1"}))
+		}()
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, RegisterStructRule(v, noop))
+		}()
+	}
+	wg.Wait()
+}
+
+func Test_RegisterStructRule_errors(t *testing.T) {
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	noop := func(ctx context.Context, value *string, report StructRuleReport) {}
+	assert.Error(t, RegisterStructRule(v, noop))
+
+	mock := NewMockValidator(gomock.NewController(t))
+	assert.Error(t, RegisterStructRule(mock, func(ctx context.Context, person *structRulePerson, report StructRuleReport) {}))
+}
+
+func Test_structPathValue(t *testing.T) {
+	order := structRuleOrder{
+		Buyer: structRulePerson{CPF: "529.982.247-25"},
+		Items: []structRuleItem{{Sku: "A1"}, {Sku: "B2"}},
+	}
+
+	tests := []struct {
+		path string
+		want any
+	}{
+		{path: "Buyer.CPF", want: "529.982.247-25"},
+		{path: "Items[1].Sku", want: "B2"},
+		{path: "Items[2].Sku", want: nil},
+		{path: "Missing", want: nil},
+		{path: "Buyer.CPF.Length", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.want, structPathValue(reflect.ValueOf(&order), tt.path))
+		})
+	}
+}
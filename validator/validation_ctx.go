@@ -0,0 +1,113 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// ValidationFuncCtx is a validation that receives the context of ValidateStruct, for rules that depend on
+// a database or another service, e.g. the email is not already registered.
+// It returns false when the field is not valid, and an error when the validation itself fails,
+// e.g. the database is down, which is not reported as an invalid field.
+type ValidationFuncCtx func(ctx context.Context, fl validator.FieldLevel) (bool, error)
+
+// validationStateKey is the context key of the validationState of a ValidateStruct call
+type validationStateKey struct{}
+
+// validationState is the state of a ValidateStruct call, shared with the validations through the context
+type validationState struct {
+	mu sync.Mutex
+	// errs are the errors returned by the ValidationFuncCtx
+	errs []error
+	// ruleFailures are the rules that failed in the tags that check several rules, e.g. password
+	ruleFailures []ruleFailure
+}
+
+// ruleFailure are the rules of a tag that failed for a field, each one is reported as its own FieldError
+type ruleFailure struct {
+	tag string
+	// field is the name of the field in its struct, e.g. Password, or with the index of a dive, e.g. Passwords[2]
+	field string
+	rules []failedRule
+}
+
+// failedRule is a rule that failed, with the code reported in FieldError.Code and its param
+type failedRule struct {
+	code  string
+	param string
+}
+
+func (s *validationState) addError(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errs = append(s.errs, err)
+}
+
+// err returns the collected errors joined, or nil when there is none
+func (s *validationState) err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return errors.Join(s.errs...)
+}
+
+// addRuleFailure records the failed rules of the tag for the field being validated, named by fl.StructFieldName()
+func (s *validationState) addRuleFailure(tag, field string, rules []failedRule) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ruleFailures = append(s.ruleFailures, ruleFailure{tag: tag, field: field, rules: rules})
+}
+
+// takeRuleFailure returns and removes the first failed rules of the tag for the field, named by err.StructField().
+// The fields with the same name in different structs, e.g. the items of a slice, are validated and reported
+// in the same order, so the first failure of the field is the one of the error.
+func (s *validationState) takeRuleFailure(tag, field string) []failedRule {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, failure := range s.ruleFailures {
+		if failure.tag == tag && failure.field == field {
+			s.ruleFailures = append(s.ruleFailures[:i], s.ruleFailures[i+1:]...)
+			return failure.rules
+		}
+	}
+	return nil
+}
+
+func contextWithValidationState(ctx context.Context) (context.Context, *validationState) {
+	state := &validationState{}
+	return context.WithValue(ctx, validationStateKey{}, state), state
+}
+
+// validationStateFromContext returns the state of the ValidateStruct call, or nil when called out of it, e.g. by Var
+func validationStateFromContext(ctx context.Context) *validationState {
+	state, _ := ctx.Value(validationStateKey{}).(*validationState)
+	return state
+}
+
+func (v *validatorImpl) RegisterValidationCtx(tag string, fn ValidationFuncCtx) error {
+	return v.validator.RegisterValidationCtx(tag, func(ctx context.Context, fl validator.FieldLevel) bool {
+		state := validationStateFromContext(ctx)
+		if state == nil {
+			// called out of ValidateStruct, e.g. by Var, so the error can only be reported as an invalid field
+			valid, err := fn(ctx, fl)
+			return valid && err == nil
+		}
+
+		// after the budget or the request deadline the remaining validations are skipped
+		if err := ctx.Err(); err != nil {
+			state.addError(err)
+			return true
+		}
+
+		valid, err := fn(ctx, fl)
+		if err != nil {
+			state.addError(err)
+			return true
+		}
+
+		return valid
+	})
+}
@@ -0,0 +1,146 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+)
+
+type tenantKey struct{}
+
+func Test_validatorImpl_RegisterValidationCtx(t *testing.T) {
+	errDatabase := errors.New("database is down")
+
+	registered := map[string][]string{
+		"tenant-a": {"john@example.com"},
+	}
+	emailAvailable := func(ctx context.Context, fl validator.FieldLevel) (bool, error) {
+		tenant, _ := ctx.Value(tenantKey{}).(string)
+		if tenant == "" {
+			return false, errDatabase
+		}
+
+		for _, email := range registered[tenant] {
+			if email == fl.Field().String() {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+
+	v, err := NewValidator()
+	assert.NoError(t, err)
+	assert.NoError(t, v.RegisterValidationCtx("email_available", emailAvailable))
+
+	type input struct {
+		Email string `json:"email" validate:"required,email,email_available"`
+	}
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		email      string
+		wantStatus int
+	}{
+		{
+			name:  "valid with the tenant from the context",
+			ctx:   context.WithValue(context.Background(), tenantKey{}, "tenant-a"),
+			email: "mary@example.com",
+		},
+		{
+			name:  "registered in another tenant",
+			ctx:   context.WithValue(context.Background(), tenantKey{}, "tenant-b"),
+			email: "john@example.com",
+		},
+		{
+			name:       "already registered",
+			ctx:        context.WithValue(context.Background(), tenantKey{}, "tenant-a"),
+			email:      "john@example.com",
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:       "infrastructure error",
+			ctx:        context.Background(),
+			email:      "john@example.com",
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.ValidateStruct(tt.ctx, input{Email: tt.email})
+			if tt.wantStatus == 0 {
+				assert.NoError(t, err)
+				return
+			}
+
+			var restErr resterrors.RestErr
+			assert.True(t, errors.As(err, &restErr))
+			assert.Equal(t, tt.wantStatus, restErr.StatusCode())
+		})
+	}
+
+	t.Run("infrastructure error is not an invalid field", func(t *testing.T) {
+		err := v.ValidateStruct(context.Background(), input{Email: "john@example.com"})
+		assert.Nil(t, FieldErrors(err))
+		assert.Contains(t, err.Error(), errDatabase.Error())
+	})
+}
+
+func TestWithValidationBudget(t *testing.T) {
+	v, err := NewValidator(WithValidationBudget(20 * time.Millisecond))
+	assert.NoError(t, err)
+
+	calls := 0
+	err = v.RegisterValidationCtx("slow", func(ctx context.Context, fl validator.FieldLevel) (bool, error) {
+		calls++
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		case <-time.After(time.Second):
+			return true, nil
+		}
+	})
+	assert.NoError(t, err)
+
+	type input struct {
+		First  string `json:"first" validate:"slow"`
+		Second string `json:"second" validate:"slow"`
+	}
+
+	start := time.Now()
+	err = v.ValidateStruct(context.Background(), input{})
+	assert.Less(t, time.Since(start), time.Second)
+
+	var restErr resterrors.RestErr
+	assert.True(t, errors.As(err, &restErr))
+	assert.Equal(t, http.StatusInternalServerError, restErr.StatusCode())
+	assert.Contains(t, err.Error(), context.DeadlineExceeded.Error())
+
+	// the second validation is skipped after the budget
+	assert.Equal(t, 1, calls)
+}
+
+func Test_validationState_ruleFailures(t *testing.T) {
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	type input struct {
+		// the failure of the scale is not reported, since the or accepts the value
+		Rate   string `json:"rate" validate:"decimal=scale:2|eq=10.555"`
+		Amount string `json:"amount" validate:"decimal=max:5"`
+		Fee    string `json:"fee" validate:"decimal=scale:2"`
+	}
+
+	err = v.ValidateStruct(context.Background(), input{Rate: "10.555", Amount: "10.555", Fee: "10.555"})
+	assert.Equal(t, []FieldError{
+		{Field: "amount", Tag: "decimal", Code: DecimalMax, Param: "5", Value: "10.555", Message: "The field 'amount' should be at most 5"},
+		{Field: "fee", Tag: "decimal", Code: DecimalScale, Param: "2", Value: "10.555", Message: "The field 'fee' should have at most 2 decimal places"},
+	}, FieldErrors(err))
+}
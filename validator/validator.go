@@ -11,26 +11,79 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/diegoclair/go_utils/dateutils"
 	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/go_utils/validator/brdocs"
 	"github.com/go-playground/validator/v10"
-	"github.com/klassmann/cpfcnpj"
 )
 
+// defaultTagName is the tag of the rules when WithTagName is not used
+const defaultTagName = "validate"
+
 // Validator is DEPRECATED.
 //
 // Deprecated: Use github.com/diegoclair/appvalidator.Validator instead.
 type Validator interface {
 	// ValidateStruct validates the given data set using the validator instance.
 	// It use the go-playground/validator/v10 package to validate the data set and return a better error message for some tags.
-	// This function returns a error of type resterrors.RestErr.
-	// It contains 4 more custom tags:
+	// This function returns a error of type resterrors.RestErr, with a list of FieldError as causes.
+	// The fields are named by their full path using the json tag (or form and query tags), e.g. items[2].address.zip_code
+	// It contains some custom tags:
 	// cpf - validate if the input is a valid cpf
-	// cnpj - validate if the input is a valid cnpj
+	// cnpj - validate if the input is a valid cnpj, numeric or alphanumeric (cnpj=numeric accepts only the legacy numeric format)
 	// required_trim - validate the tag required after trim the input (only valid for string fields type)
+	// pix_key - validate if the input is a valid PIX key; pix_key=cpf|email (or cpf email) restricts the allowed key types
+	// password - validate the input with the PasswordPolicy (see WithPasswordPolicy), reporting each failed rule as its own FieldError
+	// sensitive - never fails, marks the field to have its value redacted from the errors (see RegisterSensitiveTags)
+	// max_size, mime, ext, min_dimensions and max_dimensions - validate the uploaded *multipart.FileHeader fields
+	// phone - validate a brazilian or international phone number; phone=BR mobile restricts the region and the type (see ParsePhone)
+	// decimal and money - validate decimal numbers in strings with exact limits, e.g. money=min:0.01 max:Limit locale:pt-BR (see ParseDecimal)
+	// required_when and excluded_when - require or exclude the field when the Expression of the param is true, e.g. required_when=country == 'BR' and age >= 18 (|| must be written as or, since | separates the alternative tags)
+	// date, datetime_api, past, future, min_age, max_age and within_days - validate dates in the dateutils layouts (see WithClock)
+	// And tags for other brazilian documents: cep, cnh, renavam, pis, titulo_eleitor, plate, ie and cns (see the README)
+	// The messages are built in the locale from the context (see ContextWithLocale), with built-in en and pt-BR catalogs.
 	ValidateStruct(ctx context.Context, dataSet any) error
 
+	// Normalize applies the modifiers of the normalize (or mod) tag to the string fields of the struct pointed by ptr,
+	// writing the cleaned values back, e.g. `normalize:"trim,lower"`. Call it before ValidateStruct.
+	// Modifiers: trim, lower, upper, digits_only, unmask_cpf, collapse_spaces and title.
+	Normalize(ctx context.Context, ptr any) error
+
+	// RegisterValidationCtx adds a validation that receives the context of ValidateStruct, for rules that depend
+	// on a database or another service. The errors returned by fn are not reported as invalid fields:
+	// ValidateStruct returns them as an internal server error.
+	RegisterValidationCtx(tag string, fn ValidationFuncCtx) error
+
+	// ValidateMap validates a payload without a struct, e.g. a webhook decoded into a map, with the rules of each key,
+	// which are a tag string or a map with the rules of a nested object or of the elements of an array (see MapSelfKey).
+	// The rules can be loaded from JSON or YAML. It returns the same errors of ValidateStruct, with the keys as field paths.
+	ValidateMap(ctx context.Context, data map[string]any, rules map[string]any) error
+
+	// ValidateSlice validates each struct of the slice, e.g. the rows of a CSV import, like ValidateStruct.
+	// The causes of the error are a list of RowError, with the index of each invalid item (see RowErrors).
+	// With WithFailFast or WithMaxErrors, the items after the first error or the limit of errors are not validated.
+	ValidateSlice(ctx context.Context, items any) error
+
+	// ValidatePatch decodes the JSON merge patch into dst and validates it like ValidateStruct, but only the fields
+	// present in the JSON, including the nested ones, matched to the fields as encoding/json does. The fields under
+	// an array are validated when the array is present, since the patch replaces the whole array.
+	// dst can hold the current state of the resource, so the rules that compare fields see the patched values.
+	ValidatePatch(ctx context.Context, raw []byte, dst any) error
+
+	// RegisterMessage adds or replaces the message template of the tag for the locale.
+	// The template can use the placeholders {field} and {param}, e.g. "The field '{field}' should be a valid parking spot"
+	RegisterMessage(locale, tag, template string)
+
+	// FieldSchemaConstraints and StructSchemaConstraints return the OpenAPI constraints of the tags of the field or of
+	// the fields of the struct, like the functions of the same name, but reading the tag of the validator (see WithTagName)
+	FieldSchemaConstraints(field reflect.StructField) SchemaConstraints
+	StructSchemaConstraints(dataSet any) map[string]SchemaConstraints
+
 	// Some default Methods from go-playground/validator/v10 package
 	Var(field any, tag string) error
 	RegisterValidation(tag string, fn validator.Func) error
@@ -41,111 +94,236 @@ type Validator interface {
 }
 
 type validatorImpl struct {
-	validator *validator.Validate
+	validator        *validator.Validate
+	messages         *Messages
+	validationBudget time.Duration
+	passwordPolicy   PasswordPolicy
+	defaultLocale    string
+	failFast         bool
+	maxErrors        int
+	messageCauses    bool
+	tagName          string
+	now              func() time.Time
+	structRules      map[reflect.Type][]structRule
+	structRulesMu    sync.RWMutex
+	whenChecks       sync.Map
 }
 
 // NewValidator returns a new instance of validator interface with the custom validations tags validations.
+// The options configure the validator, e.g. WithTagName, WithMessages or WithFailFast.
 //
 // Deprecated: Use github.com/diegoclair/appvalidator.New (or
 // github.com/diegoclair/appvalidator/apperrmap.New for apperr integration)
 // instead.
-func NewValidator() (Validator, error) {
+func NewValidator(opts ...Option) (Validator, error) {
+	o := options{}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	v := &validatorImpl{
 		// the option validator.WithRequiredStructEnabled() will be default on v11 of go-playground/validator
-		validator: validator.New(validator.WithRequiredStructEnabled()),
+		validator:        validator.New(validator.WithRequiredStructEnabled()),
+		messages:         NewMessages(),
+		validationBudget: o.validationBudget,
+		passwordPolicy:   DefaultPasswordPolicy(),
+		defaultLocale:    o.defaultLocale,
+		failFast:         o.failFast,
+		maxErrors:        o.maxErrors,
+		messageCauses:    o.messageCauses,
+		tagName:          defaultTagName,
+		now:              dateutils.GetDateNowTime,
+		structRules:      map[reflect.Type][]structRule{},
+	}
+
+	if o.passwordPolicy != nil {
+		v.passwordPolicy = *o.passwordPolicy
+	}
+	if o.clock != nil {
+		v.now = o.clock
+	}
+	if o.messages != nil {
+		v.messages = o.messages
+	}
+	if o.tagName != "" {
+		v.tagName = o.tagName
+		v.validator.SetTagName(o.tagName)
 	}
 
+	// the fields are named in the errors as the client sends them
+	v.validator.RegisterTagNameFunc(fieldName)
+
 	err := v.registerCustomValidations()
 	if err != nil {
 		return nil, err
 	}
 
+	err = v.checkWhenExpressions(o.expressionTypes)
+	if err != nil {
+		return nil, resterrors.NewInternalServerError("Invalid expression: "+err.Error(), err)
+	}
+
+	// the validations of the options are registered last, so they can replace the built-in ones
+	for _, custom := range o.customValidations {
+		if custom.fnCtx != nil {
+			err = v.RegisterValidationCtx(custom.tag, custom.fnCtx)
+		} else {
+			err = v.validator.RegisterValidation(custom.tag, custom.fn)
+		}
+		if err != nil {
+			return nil, resterrors.NewInternalServerError(fmt.Sprintf("Error trying to register %s validation", custom.tag), err)
+		}
+	}
+
 	return v, nil
 }
 
 func (v *validatorImpl) ValidateStruct(ctx context.Context, dataSet any) error {
-	err := v.validator.StructCtx(ctx, dataSet)
-	if err != nil {
-		invalidArgument, ok := err.(*validator.InvalidValidationError)
-		if ok {
-			return resterrors.NewInternalServerError("Invalid argument passed to struct: "+fmt.Sprint(invalidArgument), err)
-		}
+	ctx, cancel := v.budgetContext(ctx)
+	defer cancel()
 
-		var errMessage []string
+	fieldErrors, err := v.structFieldErrors(ctx, dataSet, "", nil)
+	if err != nil {
+		return err
+	}
 
-		for _, err := range err.(validator.ValidationErrors) {
+	if len(fieldErrors) == 0 {
+		return nil
+	}
 
-			name := err.StructField()
+	return v.invalidInputError(v.limitFieldErrors(fieldErrors))
+}
 
-			switch err.Tag() {
-			case "required", "required_trim":
-				errMessage = append(errMessage, fmt.Sprintf("The field '%s' is required", name))
+// budgetContext returns the context of a validation, canceled when the validation budget is exceeded
+func (v *validatorImpl) budgetContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if v.validationBudget > 0 {
+		return context.WithTimeout(ctx, v.validationBudget)
+	}
+	return ctx, func() {}
+}
 
-			case "email":
-				errMessage = append(errMessage, fmt.Sprintf("The field '%s' should be a valid email", name))
+// structFieldErrors validates the data set and returns all its invalid fields,
+// or an error when the validation itself fails, e.g. a validation registered with RegisterValidationCtx.
+// When skip is not nil, the fields it skips are not validated, and the struct rules reported on them are removed.
+// The paths of the fields start with the prefix, e.g. [2]. for the items of a slice.
+func (v *validatorImpl) structFieldErrors(ctx context.Context, dataSet any, prefix string, skip validator.FilterFunc) ([]FieldError, error) {
+	// the expressions of the struct type are checked on its first validation, see WithExpressionChecks
+	if typ := indirectType(reflect.TypeOf(dataSet)); typ != nil && typ.Kind() == reflect.Struct {
+		if err := v.whenExpressionsError(typ); err != nil {
+			return nil, resterrors.NewInternalServerError("Invalid expression: "+err.Error(), err)
+		}
+	}
 
-			case "eq":
-				errMessage = append(errMessage, fmt.Sprintf("The value '%s' should be equal to the %s", name, err.Param()))
+	ctx, state := contextWithValidationState(ctx)
 
-			case "eqfield":
-				errMessage = append(errMessage, fmt.Sprintf("The field '%s' should be equal to the field %s", name, err.Param()))
+	var err error
+	if skip == nil {
+		err = v.validator.StructCtx(ctx, dataSet)
+	} else {
+		err = v.validator.StructFilteredCtx(ctx, dataSet, skip)
+	}
 
-			case "ne":
-				errMessage = append(errMessage, fmt.Sprintf("The value '%s' should not be equal to the %s", name, err.Param()))
+	// the errors of the validations registered with RegisterValidationCtx are not invalid fields
+	if validationErr := state.err(); validationErr != nil {
+		return nil, resterrors.NewInternalServerError("Error trying to validate the input data", validationErr)
+	}
 
-			case "gte":
-				errMessage = append(errMessage, fmt.Sprintf("The field '%s' should be greater than or equal %s", name, err.Param()))
+	if err == nil {
+		return nil, nil
+	}
 
-			case "gt":
-				errMessage = append(errMessage, fmt.Sprintf("The field '%s' should be greater than %s", name, err.Param()))
+	invalidArgument, ok := err.(*validator.InvalidValidationError)
+	if ok {
+		return nil, resterrors.NewInternalServerError("Invalid argument passed to struct: "+fmt.Sprint(invalidArgument), err)
+	}
 
-			case "lte":
-				errMessage = append(errMessage, fmt.Sprintf("The field '%s' should be less than or equal %s", name, err.Param()))
+	errs := err.(validator.ValidationErrors)
+	if skip != nil {
+		errs = slices.DeleteFunc(errs, func(fieldErr validator.FieldError) bool {
+			return skip([]byte(fieldErr.StructNamespace()))
+		})
+	}
 
-			case "lt":
-				errMessage = append(errMessage, fmt.Sprintf("The field '%s' should be less than %s", name, err.Param()))
+	return v.newFieldErrors(ctx, dataSet, prefix, errs), nil
+}
 
-			case "max":
-				errMessage = append(errMessage, fmt.Sprintf("The field '%s' should have the max lenhgt or value: %s", name, err.Param()))
+// limitFieldErrors returns only the first field error with fail fast, and at most the max errors when set
+func (v *validatorImpl) limitFieldErrors(fieldErrors []FieldError) []FieldError {
+	limit := v.maxErrors
+	if v.failFast {
+		limit = 1
+	}
 
-			case "min":
-				errMessage = append(errMessage, fmt.Sprintf("The field '%s' should have the minimun lenhgt or value: %s", name, err.Param()))
+	if limit > 0 && len(fieldErrors) > limit {
+		return fieldErrors[:limit]
+	}
+	return fieldErrors
+}
 
-			case "uuid4":
-				errMessage = append(errMessage, fmt.Sprintf("The format of '%s' should be uuid4: %s", name, err.Param()))
+func (v *validatorImpl) registerCustomValidations() error {
+	var err error
 
-			case "cpf":
-				errMessage = append(errMessage, fmt.Sprintf("The field '%s' should be a valid cpf", name))
+	// cpf, cnpj, email, phone and evp are also the types of pix_key, e.g. pix_key=cpf|email
+	for keyType, fn := range v.pixKeyTypeValidations() {
+		err = v.validator.RegisterValidation(string(keyType), v.pixKeyTypeTag(fn))
+		if err != nil {
+			return resterrors.NewInternalServerError(fmt.Sprintf("Error trying to register %s validation", keyType), err)
+		}
+	}
 
-			case "cnpj":
-				errMessage = append(errMessage, fmt.Sprintf("The field '%s' should be a valid cnpj", name))
+	for tag, fn := range brazilianDocumentValidations {
+		err = v.validator.RegisterValidation(tag, fn)
+		if err != nil {
+			return resterrors.NewInternalServerError(fmt.Sprintf("Error trying to register %s validation", tag), err)
+		}
+	}
 
-			default:
-				errMessage = append(errMessage, fmt.Sprintf("The field '%s' is invalid.", name))
+	for _, validations := range []map[string]validator.Func{bankValidations, v.boletoValidations(), fileValidations, v.dateValidations()} {
+		for tag, fn := range validations {
+			err = v.validator.RegisterValidation(tag, fn)
+			if err != nil {
+				return resterrors.NewInternalServerError(fmt.Sprintf("Error trying to register %s validation", tag), err)
 			}
 		}
+	}
 
-		return resterrors.NewUnprocessableEntity("Invalid input data", errMessage)
+	// the sensitive marker only redacts the value of the field from the errors, see fieldErrorValue
+	err = v.validator.RegisterValidation(sensitiveMarker, func(fl validator.FieldLevel) bool {
+		return true
+	})
+	if err != nil {
+		return resterrors.NewInternalServerError("Error trying to register sensitive validation", err)
 	}
 
-	return nil
-}
+	err = v.validator.RegisterValidation("pix_key", v.validPixKey)
+	if err != nil {
+		return resterrors.NewInternalServerError("Error trying to register pix_key validation", err)
+	}
 
-func (v *validatorImpl) registerCustomValidations() error {
-	err := v.validator.RegisterValidation("cpf", func(fl validator.FieldLevel) bool {
-		cpf := cpfcnpj.NewCPF(fl.Field().String())
-		return cpf.IsValid()
-	})
+	err = v.validator.RegisterValidationCtx("decimal", validDecimal)
 	if err != nil {
-		return resterrors.NewInternalServerError("Error trying to register cpf validation", err)
+		return resterrors.NewInternalServerError("Error trying to register decimal validation", err)
 	}
 
-	err = v.validator.RegisterValidation("cnpj", func(fl validator.FieldLevel) bool {
-		cnpj := cpfcnpj.NewCNPJ(fl.Field().String())
-		return cnpj.IsValid()
-	})
+	err = v.validator.RegisterValidationCtx("money", validMoney)
 	if err != nil {
-		return resterrors.NewInternalServerError("Error trying to register cnpj validation", err)
+		return resterrors.NewInternalServerError("Error trying to register money validation", err)
+	}
+
+	// the conditions are evaluated also for the nil fields, which are the ones they require or exclude
+	err = v.validator.RegisterValidationCtx("required_when", validWhen(true), true)
+	if err != nil {
+		return resterrors.NewInternalServerError("Error trying to register required_when validation", err)
+	}
+
+	err = v.validator.RegisterValidationCtx("excluded_when", validWhen(false), true)
+	if err != nil {
+		return resterrors.NewInternalServerError("Error trying to register excluded_when validation", err)
+	}
+
+	err = v.validator.RegisterValidationCtx("password", v.validPassword)
+	if err != nil {
+		return resterrors.NewInternalServerError("Error trying to register password validation", err)
 	}
 
 	err = v.validator.RegisterValidation("required_trim", func(fl validator.FieldLevel) bool {
@@ -165,8 +343,32 @@ func (v *validatorImpl) registerCustomValidations() error {
 	return nil
 }
 
+func validCPF(fl validator.FieldLevel) bool {
+	return brdocs.IsValidCPF(fl.Field().String())
+}
+
+// validCNPJ accepts the numeric and the alphanumeric CNPJ, or only the numeric one with cnpj=numeric
+func validCNPJ(fl validator.FieldLevel) bool {
+	if fl.Param() == "numeric" {
+		return brdocs.IsValidNumericCNPJ(fl.Field().String())
+	}
+	return brdocs.IsValidCNPJ(fl.Field().String())
+}
+
+func (v *validatorImpl) RegisterMessage(locale, tag, template string) {
+	v.messages.Register(locale, tag, template)
+}
+
+// locale returns the locale of the messages: the one from the context or the default locale of the validator
+func (v *validatorImpl) locale(ctx context.Context) string {
+	if locale := LocaleFromContext(ctx); locale != "" {
+		return locale
+	}
+	return v.defaultLocale
+}
+
 func (v *validatorImpl) Var(field any, tag string) error {
-	return v.validator.Var(field, tag)
+	return v.validator.Var(field, escapePixKeyGroups(tag))
 }
 
 func (v *validatorImpl) RegisterValidation(tag string, fn validator.Func) error {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/diegoclair/go_utils/resterrors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -174,7 +175,7 @@ func Test_validatorImpl_ValidateStruct(t *testing.T) {
 			},
 			checkError: func(err error) {
 				assert.Error(t, err)
-				assert.Contains(t, err.Error(), "The field 'Age' should have the max lenhgt or value: 18")
+				assert.Contains(t, err.Error(), "The field 'Age' should have the max length or value: 18")
 			},
 		},
 		{
@@ -188,7 +189,7 @@ func Test_validatorImpl_ValidateStruct(t *testing.T) {
 			},
 			checkError: func(err error) {
 				assert.Error(t, err)
-				assert.Contains(t, err.Error(), "The field 'Age' should have the minimun lenhgt or value: 18")
+				assert.Contains(t, err.Error(), "The field 'Age' should have the minimum length or value: 18")
 			},
 		},
 		{
@@ -202,7 +203,7 @@ func Test_validatorImpl_ValidateStruct(t *testing.T) {
 			},
 			checkError: func(err error) {
 				assert.Error(t, err)
-				assert.Contains(t, err.Error(), "The format of 'UUID' should be uuid4: ")
+				assert.Contains(t, err.Error(), "The format of 'UUID' should be uuid4")
 			},
 		},
 		{
@@ -233,6 +234,33 @@ func Test_validatorImpl_ValidateStruct(t *testing.T) {
 				assert.Contains(t, err.Error(), "The field 'CNPJ' should be a valid cnpj")
 			},
 		},
+		{
+			name: "should not return error for an alphanumeric cnpj",
+			args: args{
+				dataSet: struct {
+					CNPJ string `validate:"cnpj"`
+				}{
+					CNPJ: "12.ABC.345/01DE-35",
+				},
+			},
+			checkError: func(err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "should return error for an alphanumeric cnpj with tag cnpj=numeric",
+			args: args{
+				dataSet: struct {
+					CNPJ string `validate:"cnpj=numeric"`
+				}{
+					CNPJ: "12.ABC.345/01DE-35",
+				},
+			},
+			checkError: func(err error) {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "The field 'CNPJ' should be a valid cnpj")
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -242,3 +270,113 @@ func Test_validatorImpl_ValidateStruct(t *testing.T) {
 		})
 	}
 }
+
+func Test_validatorImpl_ValidateStruct_FieldPaths(t *testing.T) {
+	ctx := context.Background()
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	type address struct {
+		ZipCode string `json:"zip_code" validate:"required"`
+	}
+	type item struct {
+		Address address `json:"address"`
+	}
+	type Base struct {
+		ID string `json:"id" validate:"required"`
+	}
+	type order struct {
+		Base
+		DocumentNumber string   `json:"document_number,omitempty" validate:"required"`
+		Search         string   `form:"search" validate:"required"`
+		Page           int      `json:"-" query:"page" validate:"gte=1"`
+		Items          []item   `json:"items" validate:"dive"`
+		Password       string   `json:"password" validate:"required"`
+		Confirm        string   `json:"confirm_password" validate:"eqfield=Password"`
+		Tags           []string `json:"tags" validate:"dive,required"`
+	}
+
+	tests := []struct {
+		name          string
+		dataSet       any
+		expectedField string
+		expectedTag   string
+		expectedMsg   string
+		expectedParam string
+	}{
+		{
+			name:          "should use the json tag name",
+			dataSet:       order{Base: Base{ID: "1"}, Search: "a", Page: 1, Password: "a", Confirm: "a"},
+			expectedField: "document_number",
+			expectedTag:   "required",
+			expectedMsg:   "The field 'document_number' is required",
+		},
+		{
+			name:          "should fall back to the form tag name",
+			dataSet:       order{Base: Base{ID: "1"}, DocumentNumber: "1", Page: 1, Password: "a", Confirm: "a"},
+			expectedField: "search",
+			expectedTag:   "required",
+		},
+		{
+			name:          "should fall back to the query tag name when json is ignored",
+			dataSet:       &order{Base: Base{ID: "1"}, DocumentNumber: "1", Search: "a", Password: "a", Confirm: "a"},
+			expectedField: "page",
+			expectedTag:   "gte",
+			expectedParam: "1",
+		},
+		{
+			name:          "should promote the fields of embedded structs",
+			dataSet:       order{DocumentNumber: "1", Search: "a", Page: 1, Password: "a", Confirm: "a"},
+			expectedField: "id",
+			expectedTag:   "required",
+		},
+		{
+			name: "should build the full path of nested structs inside slices",
+			dataSet: order{Base: Base{ID: "1"}, DocumentNumber: "1", Search: "a", Page: 1, Password: "a", Confirm: "a",
+				Items: []item{{Address: address{ZipCode: "1"}}, {Address: address{ZipCode: "1"}}, {}},
+			},
+			expectedField: "items[2].address.zip_code",
+			expectedTag:   "required",
+			expectedMsg:   "The field 'items[2].address.zip_code' is required",
+		},
+		{
+			name: "should build the path of slice elements",
+			dataSet: order{Base: Base{ID: "1"}, DocumentNumber: "1", Search: "a", Page: 1, Password: "a", Confirm: "a",
+				Tags: []string{"a", ""},
+			},
+			expectedField: "tags[1]",
+			expectedTag:   "required",
+		},
+		{
+			name:          "should rename the referenced field in the param",
+			dataSet:       order{Base: Base{ID: "1"}, DocumentNumber: "1", Search: "a", Page: 1, Password: "a", Confirm: "b"},
+			expectedField: "confirm_password",
+			expectedTag:   "eqfield",
+			expectedParam: "password",
+			expectedMsg:   "The field 'confirm_password' should be equal to the field password",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.ValidateStruct(ctx, tt.dataSet)
+			restErr, ok := err.(resterrors.RestErr)
+			if !assert.True(t, ok) {
+				return
+			}
+
+			causes := restErr.Causes().([]any)
+			fieldErrors := causes[0].([]FieldError)
+			if !assert.Len(t, fieldErrors, 1) {
+				return
+			}
+
+			assert.Equal(t, tt.expectedField, fieldErrors[0].Field)
+			assert.Equal(t, tt.expectedTag, fieldErrors[0].Tag)
+			assert.Equal(t, tt.expectedParam, fieldErrors[0].Param)
+			if tt.expectedMsg != "" {
+				assert.Equal(t, tt.expectedMsg, fieldErrors[0].Message)
+			}
+		})
+	}
+}
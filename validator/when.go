@@ -0,0 +1,149 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// whenTags are the tags whose param is an Expression, required_when and excluded_when
+var whenTags = map[string]bool{"required_when": true, "excluded_when": true}
+
+// validWhen returns the validation of the tag required_when, when required is true, or excluded_when.
+// The param is an Expression over the fields of the struct, e.g. required_when=country == 'BR' && age >= 18.
+// A malformed expression is reported like the errors of RegisterValidationCtx, as an internal server error
+// with the name of the field.
+func validWhen(required bool) validator.FuncCtx {
+	return func(ctx context.Context, fl validator.FieldLevel) bool {
+		matched, err := evalWhen(fl)
+		if err != nil {
+			if parent := reflect.Indirect(fl.Parent()); parent.Kind() == reflect.Struct {
+				err = fmt.Errorf("field %s.%s: %w", parent.Type().Name(), fl.StructFieldName(), err)
+			}
+			if state := validationStateFromContext(ctx); state != nil {
+				state.addError(err)
+				return true
+			}
+			return false
+		}
+
+		if !matched {
+			return true
+		}
+		return hasValue(fl.Field()) == required
+	}
+}
+
+// evalWhen evaluates the expression of the param with the struct of the field and the top level struct
+func evalWhen(fl validator.FieldLevel) (bool, error) {
+	expr, err := CompileExpression(fl.Param())
+	if err != nil {
+		return false, err
+	}
+
+	// the values validated without a struct, e.g. by Var or ValidateMap, have no fields to evaluate
+	parent, top := reflect.Indirect(fl.Parent()), reflect.Indirect(fl.Top())
+	if parent.Kind() != reflect.Struct || top.Kind() != reflect.Struct {
+		return false, fmt.Errorf("the expression %q can only be evaluated in the fields of a struct", fl.Param())
+	}
+
+	return expr.eval(parent, top)
+}
+
+// hasValue reports whether the field has a value, as the required tag of go-playground does:
+// the pointers, slices and maps are not nil and the other kinds are not their zero value
+func hasValue(field reflect.Value) bool {
+	switch field.Kind() {
+	case reflect.Invalid:
+		return false
+	case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map, reflect.Chan, reflect.Func:
+		return !field.IsNil()
+	}
+	return !field.IsZero()
+}
+
+// checkWhenExpressions compiles the expressions of the required_when and excluded_when tags of the struct types
+// and of their nested structs, returning the first error, so the malformed expressions are found by NewValidator
+func (v *validatorImpl) checkWhenExpressions(types []any) error {
+	for _, sample := range types {
+		top := indirectType(reflect.TypeOf(sample))
+		if top == nil || top.Kind() != reflect.Struct {
+			return fmt.Errorf("invalid type passed to expression checks: expected a struct, got %T", sample)
+		}
+
+		if err := v.whenExpressionsError(top); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// whenCheck is the result of the check of the expressions of a struct type, cached also when it fails
+type whenCheck struct {
+	err error
+}
+
+// whenExpressionsError checks the expressions of the struct type and of its nested structs once per type,
+// so the validations of a type with a malformed expression fail even when its field is not validated,
+// e.g. an empty field with omitempty
+func (v *validatorImpl) whenExpressionsError(top reflect.Type) error {
+	if cached, ok := v.whenChecks.Load(top); ok {
+		return cached.(whenCheck).err
+	}
+
+	err := v.checkStructWhenExpressions(top, top, map[reflect.Type]bool{})
+	v.whenChecks.Store(top, whenCheck{err: err})
+	return err
+}
+
+func (v *validatorImpl) checkStructWhenExpressions(typ, top reflect.Type, visited map[reflect.Type]bool) error {
+	if visited[typ] {
+		return nil
+	}
+	visited[typ] = true
+
+	for _, fld := range reflect.VisibleFields(typ) {
+		if !fld.IsExported() {
+			continue
+		}
+
+		for _, param := range whenParams(fld.Tag.Get(v.tagName)) {
+			expr, err := CompileExpression(param)
+			if err == nil {
+				_, err = expr.bind(typ, top)
+			}
+			if err != nil {
+				return fmt.Errorf("field %s.%s: %w", typ.Name(), fld.Name, err)
+			}
+		}
+
+		nested := indirectType(fld.Type)
+		for nested.Kind() == reflect.Slice || nested.Kind() == reflect.Array || nested.Kind() == reflect.Map {
+			nested = indirectType(nested.Elem())
+		}
+		if nested.Kind() == reflect.Struct {
+			if err := v.checkStructWhenExpressions(nested, top, visited); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// whenParams returns the params of the required_when and excluded_when tags of the rules of a field,
+// unescaped as go-playground does
+func whenParams(rules string) []string {
+	var params []string
+	for _, rule := range strings.Split(rules, ",") {
+		for _, alternative := range strings.Split(rule, "|") {
+			name, param, _ := strings.Cut(strings.TrimSpace(alternative), "=")
+			if whenTags[name] {
+				params = append(params, strings.NewReplacer("0x2C", ",", "0x7C", "|").Replace(param))
+			}
+		}
+	}
+	return params
+}
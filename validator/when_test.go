@@ -0,0 +1,181 @@
+package validator
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/stretchr/testify/assert"
+)
+
+type whenAddress struct {
+	State   string `json:"state" validate:"required_when=$root.country == 'BR'"`
+	ZipCode string `json:"zip_code" validate:"required_when=$root.country == 'BR' and state != 'EX'"`
+}
+
+type whenOnboarding struct {
+	Country     string       `json:"country" validate:"required"`
+	Age         int          `json:"age"`
+	CPF         string       `json:"cpf" validate:"required_when=country == 'BR' && age >= 18"`
+	Passport    *string      `json:"passport" validate:"required_when=country != 'BR',excluded_when=country == 'BR'"`
+	Guardian    string       `json:"guardian" validate:"required_when=age < 18 or (country == 'US' and age < 21)"`
+	Address     whenAddress  `json:"address"`
+	Previous    []string     `json:"previous" validate:"excluded_when=not has_history"`
+	HasHistory  bool         `json:"has_history"`
+	Billing     *whenAddress `json:"billing"`
+	Nationality string       `json:"nationality" validate:"omitempty,required_when=country == 'BR'0x7C0x7Cage > 60"`
+}
+
+func Test_validatorImpl_ValidateStruct_When(t *testing.T) {
+	v, err := NewValidator(WithExpressionChecks(whenOnboarding{}))
+	assert.NoError(t, err)
+
+	passport := "X123"
+	valid := func() whenOnboarding {
+		return whenOnboarding{Country: "BR", Age: 30, CPF: "529.982.247-25", Address: whenAddress{State: "SP", ZipCode: "01310-100"}}
+	}
+
+	tests := []struct {
+		name       string
+		onboarding func() whenOnboarding
+		wantErr    []FieldError
+	}{
+		{
+			name:       "valid",
+			onboarding: valid,
+		},
+		{
+			name: "required when the condition is true",
+			onboarding: func() whenOnboarding {
+				o := valid()
+				o.CPF = ""
+				return o
+			},
+			wantErr: []FieldError{
+				{Field: "cpf", Tag: "required_when", Param: "country == 'BR' && age >= 18", Message: "The field 'cpf' is required when country == 'BR' && age >= 18"},
+			},
+		},
+		{
+			name: "not required when the condition is false",
+			onboarding: func() whenOnboarding {
+				return whenOnboarding{Country: "AR", Age: 30, Passport: &passport}
+			},
+		},
+		{
+			name: "nil pointers are required and excluded",
+			onboarding: func() whenOnboarding {
+				o := valid()
+				o.Passport = &passport
+				o.Previous = []string{"AR"}
+				return o
+			},
+			wantErr: []FieldError{
+				{Field: "passport", Tag: "excluded_when", Param: "country == 'BR'", Value: "X123", Message: "The field 'passport' should not be present when country == 'BR'"},
+				{Field: "previous", Tag: "excluded_when", Param: "not has_history", Message: "The field 'previous' should not be present when not has_history"},
+			},
+		},
+		{
+			name: "parentheses and the fields of the top level struct in a nested struct",
+			onboarding: func() whenOnboarding {
+				return whenOnboarding{Country: "US", Age: 20, Passport: &passport, Billing: &whenAddress{}}
+			},
+			wantErr: []FieldError{
+				{Field: "guardian", Tag: "required_when", Param: "age < 18 or (country == 'US' and age < 21)", Message: "The field 'guardian' is required when age < 18 or (country == 'US' and age < 21)"},
+			},
+		},
+		{
+			name: "nested structs",
+			onboarding: func() whenOnboarding {
+				o := valid()
+				o.Address = whenAddress{}
+				o.Billing = &whenAddress{State: "EX"}
+				return o
+			},
+			wantErr: []FieldError{
+				{Field: "address.state", Tag: "required_when", Param: "$root.country == 'BR'", Message: "The field 'address.state' is required when $root.country == 'BR'"},
+				{Field: "address.zip_code", Tag: "required_when", Param: "$root.country == 'BR' and state != 'EX'", Message: "The field 'address.zip_code' is required when $root.country == 'BR' and state != 'EX'"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			onboarding := tt.onboarding()
+			err := v.ValidateStruct(context.Background(), onboarding)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tt.wantErr, FieldErrors(err))
+		})
+	}
+}
+
+func Test_validatorImpl_ValidateStruct_WhenInvalidExpression(t *testing.T) {
+	type invalid struct {
+		Country string `json:"country"`
+		CPF     string `json:"cpf" validate:"required_when=contry == 'BR'"`
+	}
+
+	t.Run("checked by NewValidator", func(t *testing.T) {
+		v, err := NewValidator(WithExpressionChecks(whenOnboarding{}, &invalid{}))
+		assert.Nil(t, v)
+
+		var restErr resterrors.RestErr
+		if assert.ErrorAs(t, err, &restErr) {
+			assert.Equal(t, http.StatusInternalServerError, restErr.StatusCode())
+			assert.Equal(t, `Invalid expression: field invalid.CPF: invalid expression "contry == 'BR'" at position 0: unknown field "contry" in validator.invalid`, restErr.Message())
+		}
+
+		_, err = NewValidator(WithExpressionChecks("not a struct"))
+		assert.Error(t, err)
+	})
+
+	t.Run("reported by the validation", func(t *testing.T) {
+		v, err := NewValidator()
+		assert.NoError(t, err)
+
+		err = v.ValidateStruct(context.Background(), invalid{Country: "BR"})
+
+		var restErr resterrors.RestErr
+		if assert.ErrorAs(t, err, &restErr) {
+			assert.Equal(t, http.StatusInternalServerError, restErr.StatusCode())
+			assert.Equal(t, `Invalid expression: field invalid.CPF: invalid expression "contry == 'BR'" at position 0: unknown field "contry" in validator.invalid`, restErr.Message())
+		}
+
+		// the expressions are checked on the first validation of the type, also in the fields that are not validated
+		type skipped struct {
+			Country string `json:"country"`
+			Age     int    `json:"age"`
+			CPF     string `json:"cpf" validate:"omitempty,required_when=age == 'x'"`
+		}
+		err = v.ValidateStruct(context.Background(), skipped{})
+		if assert.ErrorAs(t, err, &restErr) {
+			assert.Equal(t, http.StatusInternalServerError, restErr.StatusCode())
+			assert.Contains(t, restErr.Message(), "field skipped.CPF")
+		}
+
+		// the values validated without a struct can not be evaluated
+		assert.Error(t, v.Var("", "required_when=country == 'BR'"))
+	})
+}
+
+func TestExpression_structRule(t *testing.T) {
+	v, err := NewValidator()
+	assert.NoError(t, err)
+
+	adult := MustCompileExpression("country == 'BR' && age >= 18")
+	assert.NoError(t, adult.Check(whenOnboarding{}))
+
+	assert.NoError(t, RegisterStructRule(v, func(ctx context.Context, o *whenOnboarding, report StructRuleReport) {
+		if ok, _ := adult.Eval(o); ok && o.Address.ZipCode == "" {
+			report("Address.ZipCode", "required", "")
+		}
+	}))
+
+	err = v.ValidateStruct(context.Background(), whenOnboarding{Country: "BR", Age: 18, CPF: "529.982.247-25", Address: whenAddress{State: "EX"}})
+	assert.Equal(t, []FieldError{
+		{Field: "address.zip_code", Tag: "required", Message: "The field 'address.zip_code' is required"},
+	}, FieldErrors(err))
+}